@@ -50,6 +50,10 @@ type Event struct {
 	StartTime               time.Time
 	OtherLegCalleeIdName    string
 	CallerNumber            string
+	CallerType              string
+	CalleeType              string
+	ConferenceName          string
+	ConferenceAction        string
 }
 
 func CreateEvent(eventStr string) Event {
@@ -118,6 +122,10 @@ func CreateEventFromMap(eventMap map[string]string) Event {
 	event.EffectiveCalleeIdNumber = eventMap["variable_effective_callee_id_number"]
 	event.StartTime = UnixStrToTime(eventMap["variable__START_TIME"])
 	event.OtherLegCalleeIdName = eventMap["Other-Leg-Callee-ID-Name"]
+	event.CallerType = eventMap["variable_CALLER_TYPE"]
+	event.CalleeType = eventMap["variable_CALLEE_TYPE"]
+	event.ConferenceName = eventMap["Conference-Name"]
+	event.ConferenceAction = eventMap["Action"]
 	return event
 }
 
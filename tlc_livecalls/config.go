@@ -23,6 +23,9 @@ type Config struct {
 		Port    string `yaml:"port"`
 		Timeout int    `yaml:"timeout"`
 	} `yaml:"grcp_dispatcher"`
+	Metrics struct {
+		Port int `yaml:"port"`
+	} `yaml:"metrics"`
 }
 
 func readConf(filename string) (*Config, error) {
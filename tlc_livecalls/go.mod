@@ -6,10 +6,19 @@ require (
 	github.com/fetristan/tlc_dispatcher v0.6.0
 	github.com/fetristan/tlc_logger v1.0.0
 	github.com/fetristan/tlc_sessions v1.0.2
+	github.com/prometheus/client_golang v1.14.0
 	google.golang.org/grpc v1.53.0
 )
 
-require github.com/golang/protobuf v1.5.3 // indirect
+require (
+	github.com/beorn7/perks v1.0.1 // indirect
+	github.com/cespare/xxhash/v2 v2.2.0 // indirect
+	github.com/golang/protobuf v1.5.3 // indirect
+	github.com/matttproud/golang_protobuf_extensions v1.0.1 // indirect
+	github.com/prometheus/client_model v0.3.0 // indirect
+	github.com/prometheus/common v0.37.0 // indirect
+	github.com/prometheus/procfs v0.8.0 // indirect
+)
 
 require (
 	golang.org/x/net v0.7.0 // indirect
@@ -19,3 +28,5 @@ require (
 	google.golang.org/protobuf v1.31.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1
 )
+
+replace github.com/fetristan/tlc_sessions => ../tlc_sessions
@@ -54,21 +54,25 @@ func main() {
 	dispatcherClient := message.NewMessageServiceClient(dispatcherConn)
 	log.Debugf("tlc_livecalls grcp_dispatcher connected : host: %s / port: %s/ grcp_sessions timeout : %d", config.GrcpDispatcher.Host, config.GrcpDispatcher.Port, config.GrcpDispatcher.Timeout)
 
+	//Metrics listener
+	startMetricsListener(config.Metrics.Port)
+	log.Debugf("tlc_livecalls metrics ready : port: %d", config.Metrics.Port)
+
 	//Infinite loop to take sessions, transform to livecalls and send it via dispatcher
 	for {
 		//Cycle to send live_calls
 		time.Sleep(time.Duration(config.LiveCalls.Cycle) * time.Second)
+		cycleStart := time.Now()
 
 		//Grcp sessions timeout context
 		sessionsServiceCtx, sessionsServiceCancel := context.WithTimeout(context.Background(), time.Duration(config.GrcpSessions.Timeout)*time.Second)
 		defer sessionsServiceCancel()
 
-		//Get sessions via GRCP
-		sessionCopy, err := sessionsServiceClient.GetSessionsCopyService(sessionsServiceCtx, &sessionsservice.Nil{})
+		//Get sessions of the pole via GRCP
+		sessions, err := listPoleSessions(sessionsServiceCtx, sessionsServiceClient, config.LiveCalls.Pole)
 		if err != nil {
-			log.Errorf("%v.GetSessionsCopyService(_) = _, %v", sessionsServiceClient, err)
+			log.Errorf("%v.ListSessions(_) = _, %v", sessionsServiceClient, err)
 		}
-		sessions := sessionsservice.SessionsCopyServiceToSessions(sessionCopy)
 
 		//Build live_calls
 		var livecalls []map[string]string
@@ -91,6 +95,7 @@ func main() {
 		//Send to dispatcher
 		sendLiveCallsToDispatcher(string(jsonStr), dispatcherClient, dispatcherCtx, config.LiveCalls.UrlApi, config.LiveCalls.Pole)
 		sendLiveCallsToDispatcher(string(jsonStrUnsmasked), dispatcherClient, dispatcherCtxUnsmasked, config.LiveCalls.UrlApiUnmasked, config.LiveCalls.Pole)
+		cycleDuration.Observe(time.Since(cycleStart).Seconds())
 	}
 }
 
@@ -103,24 +108,6 @@ func returnValueOrUnknown(value string) string {
 	}
 }
 
-// To set the good caller/callee type
-func returnCallerCalleeType(typeToTransform string) string {
-	switch typeToTransform {
-	case "0":
-		return "EXTERNAL"
-	case "1":
-		return "XXXXXX"
-	case "2":
-		return "YYYYYY"
-	case "3":
-		return "EXTENSION"
-	case "4":
-		return "IVR"
-	default:
-		return "IVR"
-	}
-}
-
 func anonymiseNumber(value string, unmasked bool) string {
 	if !unmasked {
 		if _, err := strconv.Atoi(value); err == nil && len(value) > 9 {
@@ -185,17 +172,22 @@ func getLiveCalls(sessions []sessionsservice.Session, unmasked bool, pole string
 					call["b_callee_name"] = returnValueOrUnknown(session.EffectiveCalleeIdName)
 				}
 			}
-			call["a_type"] = returnCallerCalleeType(session.CallerType)
+			call["a_type"] = session.CallerType.String()
 			ifNilDontCreateDateEntry(&call, "a_create_timestamp", session.DateStart)
 			ifNilDontCreateDateEntry(&call, "b_create_timestamp", session.DateStart)
 			ifNilDontCreateDateEntry(&call, "a_answer_timestamp", session.DateCon)
 			ifNilDontCreateDateEntry(&call, "b_answer_timestamp", session.DateCon)
-			call["b_type"] = returnCallerCalleeType(session.CalleeType)
+			call["b_type"] = session.CalleeType.String()
 			if session.CallState == "" {
 				call["call_state"] = "ACTIVE"
 			} else {
 				call["call_state"] = session.CallState
 			}
+			ifNilDontCreateDateEntry(&call, "hold_timestamp", session.HoldStart)
+			if session.HoldCount > 0 {
+				call["hold_accum"] = strconv.FormatInt(session.HoldAccum, 10)
+				call["hold_count"] = strconv.FormatInt(int64(session.HoldCount), 10)
+			}
 			ifNilDontCreateEntry(&call, "a_uuid", session.CallerUid)
 			ifNilDontCreateEntry(&call, "b_uuid", session.CalleeUid)
 			livecalls = append(livecalls, call)
@@ -209,6 +201,25 @@ func getLiveCalls(sessions []sessionsservice.Session, unmasked bool, pole string
 	return livecalls
 }
 
+// All the sessions of the pole, page by page
+func listPoleSessions(ctx context.Context, client sessionsservice.SessionsServiceClient, pole string) ([]sessionsservice.Session, error) {
+	var sessions []sessionsservice.Session
+	request := &sessionsservice.ListSessionsRequest{Pole: pole}
+	for {
+		reply, err := client.ListSessions(ctx, request)
+		if err != nil {
+			return sessions, err
+		}
+		for _, sessionCopy := range reply.GetSessionCopy() {
+			sessions = append(sessions, *sessionsservice.SessionServiceToSession(sessionCopy))
+		}
+		if reply.GetNextPageToken() == "" {
+			return sessions, nil
+		}
+		request.PageToken = reply.GetNextPageToken()
+	}
+}
+
 func sendLiveCallsToDispatcher(livecalls string, dispatcherClient message.MessageServiceClient, ctx context.Context, urlApi string, pole string) bool {
 	_, err := dispatcherClient.New(ctx, &message.MessageRequest{
 		Method:   "POST",
@@ -220,6 +231,7 @@ func sendLiveCallsToDispatcher(livecalls string, dispatcherClient message.Messag
 
 	if err != nil {
 		log.Error("%s", err)
+		dispatcherFailures.WithLabelValues(urlApi).Inc()
 		return false
 	}
 	return true
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var (
+	cycleDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "tlc_livecalls_cycle_duration_seconds",
+		Help:    "Time spent getting the sessions, building and sending the livecalls.",
+		Buckets: prometheus.ExponentialBuckets(0.001, 4, 8),
+	})
+	dispatcherFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "tlc_livecalls_dispatcher_failures_total",
+		Help: "Livecalls not sent to the dispatcher, by url.",
+	}, []string{"url"})
+)
+
+func init() {
+	prometheus.MustRegister(cycleDuration, dispatcherFailures)
+}
+
+// Serve the metrics over http, nothing is served if no port is configured
+func startMetricsListener(port int) {
+	if port == 0 {
+		return
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	go func() {
+		err := http.ListenAndServe(fmt.Sprintf(":%d", port), mux)
+		if err != nil {
+			log.Errorf("Metrics error: %s", err)
+		}
+	}()
+}
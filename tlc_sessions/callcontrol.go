@@ -0,0 +1,176 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	"github.com/fetristan/tlc_sessions/sessionsservice"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// An argument of a FreeSWITCH api command, one word without control characters
+func checkCommandArg(name string, value string, required bool) error {
+	if value == "" {
+		if required {
+			return status.Errorf(codes.InvalidArgument, "%s is required", name)
+		}
+		return nil
+	}
+	if strings.IndexFunc(value, func(r rune) bool { return unicode.IsSpace(r) || unicode.IsControl(r) }) >= 0 {
+		return status.Errorf(codes.InvalidArgument, "%s must not contain spaces or control characters", name)
+	}
+	return nil
+}
+
+// Connection owning the channel uid: the node of its session, else the node of its pole where it exists
+func sessionOwner(uid string) (int, error) {
+	activeSessions.Lock()
+	session, _, found := activeSessions.Get(uid, "", false, true)
+	var connIdxs []int
+	if found {
+		connIdxs = sessionConnections(session)
+	}
+	activeSessions.Unlock()
+	if !found {
+		return 0, status.Errorf(codes.NotFound, "no session for uid %s", uid)
+	}
+	if len(connIdxs) == 1 {
+		return connIdxs[0], nil
+	}
+	for _, connIdx := range connIdxs {
+		if uuidExistsOnNode(connIdx, uid) == uuidExists {
+			return connIdx, nil
+		}
+	}
+	return 0, status.Errorf(codes.Unavailable, "no connected FreeSWITCH holds uid %s", uid)
+}
+
+// Error of a FreeSWITCH api reply, failed precondition if FreeSWITCH refused the command
+func apiReplyError(reply string, err error) error {
+	if err != nil {
+		if strings.HasPrefix(strings.TrimSpace(err.Error()), "-ERR") {
+			return status.Error(codes.FailedPrecondition, strings.TrimSpace(err.Error()))
+		}
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	if strings.HasPrefix(strings.TrimSpace(reply), "-ERR") {
+		return status.Error(codes.FailedPrecondition, strings.TrimSpace(reply))
+	}
+	return nil
+}
+
+// Send the api command to the FreeSWITCH owning the channel uid
+func callControl(uid string, command string) (*sessionsservice.CallControlReply, error) {
+	connIdx, err := sessionOwner(uid)
+	if err != nil {
+		return nil, err
+	}
+	if connIdx >= len(fs) || fs[connIdx] == nil || !fs[connIdx].Connected() {
+		return nil, status.Errorf(codes.Unavailable, "FreeSWITCH %d is not connected", connIdx)
+	}
+	log.Debugf("Call control : FreeSWITCH %d : %s", connIdx, command)
+	reply, err := fs[connIdx].SendApiCmd(command)
+	if err := apiReplyError(reply, err); err != nil {
+		log.Errorf("Call control : FreeSWITCH %d : %s : %s", connIdx, command, err)
+		return nil, err
+	}
+	return &sessionsservice.CallControlReply{Reply: strings.TrimSpace(reply), Node: fsStatus[connIdx].host}, nil
+}
+
+// Hang up the channel from GRPC, with NORMAL_CLEARING if no cause is given
+func (s *server) Hangup(ctx context.Context, in *sessionsservice.HangupRequest) (*sessionsservice.CallControlReply, error) {
+	log.Debugf("Received:Hangup : %s / %s", in.GetUid(), in.GetCause())
+	if err := checkCommandArg("uid", in.GetUid(), true); err != nil {
+		return nil, err
+	}
+	if err := checkCommandArg("cause", in.GetCause(), false); err != nil {
+		return nil, err
+	}
+	cause := in.GetCause()
+	if cause == "" {
+		cause = "NORMAL_CLEARING"
+	}
+	return callControl(in.GetUid(), "uuid_kill "+in.GetUid()+" "+cause)
+}
+
+// Transfer the channel from GRPC, the dialplan and the context being optional
+func (s *server) Transfer(ctx context.Context, in *sessionsservice.TransferRequest) (*sessionsservice.CallControlReply, error) {
+	log.Debugf("Received:Transfer : %s / %s / %s / %s", in.GetUid(), in.GetDest(), in.GetDialplan(), in.GetContext())
+	if err := checkCommandArg("uid", in.GetUid(), true); err != nil {
+		return nil, err
+	}
+	if err := checkCommandArg("dest", in.GetDest(), true); err != nil {
+		return nil, err
+	}
+	if err := checkCommandArg("dialplan", in.GetDialplan(), in.GetContext() != ""); err != nil {
+		return nil, err
+	}
+	if err := checkCommandArg("context", in.GetContext(), false); err != nil {
+		return nil, err
+	}
+	command := "uuid_transfer " + in.GetUid() + " " + in.GetDest()
+	if in.GetDialplan() != "" {
+		command = command + " " + in.GetDialplan()
+	}
+	if in.GetContext() != "" {
+		command = command + " " + in.GetContext()
+	}
+	return callControl(in.GetUid(), command)
+}
+
+// Put the channel on hold from GRPC
+func (s *server) Hold(ctx context.Context, in *sessionsservice.CallControlRequest) (*sessionsservice.CallControlReply, error) {
+	log.Debugf("Received:Hold : %s", in.GetUid())
+	if err := checkCommandArg("uid", in.GetUid(), true); err != nil {
+		return nil, err
+	}
+	return callControl(in.GetUid(), "uuid_hold "+in.GetUid())
+}
+
+// Take the channel off hold from GRPC
+func (s *server) Unhold(ctx context.Context, in *sessionsservice.CallControlRequest) (*sessionsservice.CallControlReply, error) {
+	log.Debugf("Received:Unhold : %s", in.GetUid())
+	if err := checkCommandArg("uid", in.GetUid(), true); err != nil {
+		return nil, err
+	}
+	return callControl(in.GetUid(), "uuid_hold off "+in.GetUid())
+}
+
+// Start recording the channel into path from GRPC
+func (s *server) StartRecording(ctx context.Context, in *sessionsservice.RecordingRequest) (*sessionsservice.CallControlReply, error) {
+	log.Debugf("Received:StartRecording : %s / %s", in.GetUid(), in.GetPath())
+	if err := checkCommandArg("uid", in.GetUid(), true); err != nil {
+		return nil, err
+	}
+	if err := checkCommandArg("path", in.GetPath(), true); err != nil {
+		return nil, err
+	}
+	return callControl(in.GetUid(), "uuid_record "+in.GetUid()+" start "+in.GetPath())
+}
+
+// Stop recording the channel into path, or all its recordings if no path is given, from GRPC
+func (s *server) StopRecording(ctx context.Context, in *sessionsservice.RecordingRequest) (*sessionsservice.CallControlReply, error) {
+	log.Debugf("Received:StopRecording : %s / %s", in.GetUid(), in.GetPath())
+	if err := checkCommandArg("uid", in.GetUid(), true); err != nil {
+		return nil, err
+	}
+	if err := checkCommandArg("path", in.GetPath(), false); err != nil {
+		return nil, err
+	}
+	path := in.GetPath()
+	if path == "" {
+		path = "all"
+	}
+	return callControl(in.GetUid(), "uuid_record "+in.GetUid()+" stop "+path)
+}
+
+// Park the channel from GRPC
+func (s *server) Park(ctx context.Context, in *sessionsservice.CallControlRequest) (*sessionsservice.CallControlReply, error) {
+	log.Debugf("Received:Park : %s", in.GetUid())
+	if err := checkCommandArg("uid", in.GetUid(), true); err != nil {
+		return nil, err
+	}
+	return callControl(in.GetUid(), "uuid_park "+in.GetUid())
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/fetristan/tlc_sessions/sessionsservice"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCheckCommandArg(t *testing.T) {
+	for _, test := range []struct {
+		value    string
+		required bool
+		code     codes.Code
+	}{
+		{"abc-123", true, codes.OK},
+		{"", false, codes.OK},
+		{"", true, codes.InvalidArgument},
+		{"abc 123", false, codes.InvalidArgument},
+		{"abc\n+OK", false, codes.InvalidArgument},
+	} {
+		if code := status.Code(checkCommandArg("uid", test.value, test.required)); code != test.code {
+			t.Errorf("checkCommandArg(%q, %v) = %s, want %s", test.value, test.required, code, test.code)
+		}
+	}
+}
+
+func TestApiReplyError(t *testing.T) {
+	for _, test := range []struct {
+		reply string
+		err   error
+		code  codes.Code
+	}{
+		{"+OK\n", nil, codes.OK},
+		{"-ERR No such channel!\n", nil, codes.FailedPrecondition},
+		{"", errors.New("-ERR no reply"), codes.FailedPrecondition},
+		{"", errors.New("not connected"), codes.Unavailable},
+	} {
+		if code := status.Code(apiReplyError(test.reply, test.err)); code != test.code {
+			t.Errorf("apiReplyError(%q, %v) = %s, want %s", test.reply, test.err, code, test.code)
+		}
+	}
+}
+
+func TestCallControlErrors(t *testing.T) {
+	fsStatus = []*freeswitchStatus{{host: "fs1:8021", pole: "FR"}}
+	defer func() { fsStatus = nil }()
+	setTestSessions(t, []sessionsservice.Session{{CallerUid: "a", CalleeUid: "b", Pole: "FR", Node: "fs1:8021"}})
+	s := &server{}
+
+	if _, err := s.Hangup(context.Background(), &sessionsservice.HangupRequest{Uid: "missing"}); status.Code(err) != codes.NotFound {
+		t.Errorf("unknown uid : %v", err)
+	}
+	if _, err := s.Transfer(context.Background(), &sessionsservice.TransferRequest{Uid: "b"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("missing dest : %v", err)
+	}
+	if _, err := s.Hold(context.Background(), &sessionsservice.CallControlRequest{Uid: "b"}); status.Code(err) != codes.Unavailable {
+		t.Errorf("node not connected : %v", err)
+	}
+}
@@ -0,0 +1,374 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	events "github.com/fetristan/tlc_events"
+	"github.com/fetristan/tlc_sessions/sessionsservice"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Id prefix of the calls of the conferences, whose legs are never merged with other calls
+const conferenceCallPrefix = "conference:"
+
+type callLeg struct {
+	uid        string
+	role       sessionsservice.LegRole
+	num        string
+	node       string
+	dateStart  time.Time
+	dateEnd    time.Time
+	callState  string
+	bridgedUid string
+}
+
+type callHistory struct {
+	change   sessionsservice.CallChange
+	date     time.Time
+	uid      string
+	otherUid string
+	detail   string
+}
+
+// One logical call owning its legs, the sessions being the caller/callee pairs seen by the legs
+type call struct {
+	id      string
+	legs    []*callLeg
+	history []callHistory
+}
+
+// Calls of the active sessions, guarded like them by the lock of activeSessions
+type callRegistry struct {
+	byId  map[string]*call
+	byUid map[string]*call
+}
+
+var activeCalls = newCallRegistry()
+
+func newCallRegistry() *callRegistry {
+	return &callRegistry{byId: make(map[string]*call), byUid: make(map[string]*call)}
+}
+
+func (c *call) leg(uid string) *callLeg {
+	for _, leg := range c.legs {
+		if leg.uid == uid {
+			return leg
+		}
+	}
+	return nil
+}
+
+func (c *call) record(change sessionsservice.CallChange, date time.Time, uid string, otherUid string, detail string) {
+	c.history = append(c.history, callHistory{change: change, date: date, uid: uid, otherUid: otherUid, detail: detail})
+}
+
+func (c *call) ended() bool {
+	for _, leg := range c.legs {
+		if leg.dateEnd.IsZero() {
+			return false
+		}
+	}
+	return true
+}
+
+func (registry *callRegistry) callOf(uid string) *call {
+	if uid == "" {
+		return nil
+	}
+	return registry.byUid[uid]
+}
+
+func (registry *callRegistry) newCall(id string) *call {
+	c := &call{id: id}
+	registry.byId[id] = c
+	return c
+}
+
+func (registry *callRegistry) addLeg(c *call, uid string, role sessionsservice.LegRole, date time.Time) *callLeg {
+	leg := &callLeg{uid: uid, role: role, dateStart: date}
+	c.legs = append(c.legs, leg)
+	registry.byUid[uid] = c
+	c.record(sessionsservice.CallChange_LEG_ADDED, date, uid, "", role.String())
+	return leg
+}
+
+// A destination ringing while another one still rings makes them members of a call group
+func (registry *callRegistry) addDestination(c *call, uid string, date time.Time) {
+	leg := registry.addLeg(c, uid, sessionsservice.LegRole_LEG_DESTINATION, date)
+	for _, other := range c.legs {
+		if other == leg || !other.dateEnd.IsZero() || other.bridgedUid != "" {
+			continue
+		}
+		if other.role == sessionsservice.LegRole_LEG_DESTINATION || other.role == sessionsservice.LegRole_LEG_GROUP_MEMBER {
+			other.role = sessionsservice.LegRole_LEG_GROUP_MEMBER
+			leg.role = sessionsservice.LegRole_LEG_GROUP_MEMBER
+		}
+	}
+}
+
+func (registry *callRegistry) removeCall(c *call) {
+	delete(registry.byId, c.id)
+	for _, leg := range c.legs {
+		if registry.byUid[leg.uid] == c {
+			delete(registry.byUid, leg.uid)
+		}
+	}
+}
+
+// Move the legs and the history of from into into
+func (registry *callRegistry) merge(into *call, from *call, date time.Time) {
+	for _, leg := range from.legs {
+		into.legs = append(into.legs, leg)
+		registry.byUid[leg.uid] = into
+	}
+	into.history = append(into.history, from.history...)
+	into.record(sessionsservice.CallChange_MERGED, date, "", "", from.id)
+	delete(registry.byId, from.id)
+}
+
+// Attach the legs of the session to their call, creating or merging the calls as needed, and return its id
+func (registry *callRegistry) trackSession(session *sessionsservice.Session) string {
+	date := session.DateStart
+	if date.IsZero() {
+		date = time.Now()
+	}
+	if monitored := registry.callOf(session.MonitoredUid); monitored != nil && registry.callOf(session.CallerUid) == nil {
+		registry.addLeg(monitored, session.CallerUid, sessionsservice.LegRole_LEG_SUPERVISOR, date)
+	}
+	callerCall, calleeCall := registry.callOf(session.CallerUid), registry.callOf(session.CalleeUid)
+	switch {
+	case callerCall == nil && calleeCall == nil:
+		if session.CallerUid == "" && session.CalleeUid == "" {
+			return ""
+		}
+		if session.CallerUid != "" {
+			callerCall = registry.newCall(session.CallerUid)
+			registry.addLeg(callerCall, session.CallerUid, sessionsservice.LegRole_LEG_ORIGINATOR, date)
+			if session.CalleeUid != "" {
+				registry.addDestination(callerCall, session.CalleeUid, date)
+			}
+		} else {
+			calleeCall = registry.newCall(session.CalleeUid)
+			registry.addLeg(calleeCall, session.CalleeUid, sessionsservice.LegRole_LEG_ORIGINATOR, date)
+		}
+	case callerCall != nil && calleeCall == nil && session.CalleeUid != "":
+		registry.addDestination(callerCall, session.CalleeUid, date)
+	case callerCall == nil && calleeCall != nil && session.CallerUid != "":
+		registry.addDestination(calleeCall, session.CallerUid, date)
+	case callerCall != nil && calleeCall != nil && callerCall != calleeCall:
+		if !strings.HasPrefix(callerCall.id, conferenceCallPrefix) && !strings.HasPrefix(calleeCall.id, conferenceCallPrefix) {
+			registry.merge(callerCall, calleeCall, time.Now())
+		}
+	}
+	for uid, num := range map[string]string{session.CallerUid: session.CallerNum, session.CalleeUid: session.CalleeNum} {
+		if c := registry.callOf(uid); c != nil {
+			leg := c.leg(uid)
+			leg.num = num
+			leg.node = session.Node
+			if leg.dateEnd.IsZero() {
+				leg.callState = session.CallState
+			}
+		}
+	}
+	if c := registry.callOf(session.CallerUid); c != nil {
+		return c.id
+	}
+	if c := registry.callOf(session.CalleeUid); c != nil {
+		return c.id
+	}
+	return ""
+}
+
+func (registry *callRegistry) bridge(uid string, otherUid string, date time.Time) {
+	c := registry.callOf(uid)
+	if c == nil || otherUid == "" || registry.callOf(otherUid) != c {
+		return
+	}
+	for _, pair := range [][2]string{{uid, otherUid}, {otherUid, uid}} {
+		leg := c.leg(pair[0])
+		leg.bridgedUid = pair[1]
+		if leg.role == sessionsservice.LegRole_LEG_GROUP_MEMBER {
+			leg.role = sessionsservice.LegRole_LEG_DESTINATION
+		}
+	}
+	c.record(sessionsservice.CallChange_BRIDGED, date, uid, otherUid, "")
+}
+
+func (registry *callRegistry) unbridge(uid string, otherUid string, date time.Time) {
+	c := registry.callOf(uid)
+	if c == nil {
+		return
+	}
+	for _, oneUid := range []string{uid, otherUid} {
+		if leg := c.leg(oneUid); leg != nil && registry.callOf(oneUid) == c {
+			leg.bridgedUid = ""
+		}
+	}
+	c.record(sessionsservice.CallChange_UNBRIDGED, date, uid, otherUid, "")
+}
+
+// The leg toUid replaced fromUid in front of stayUid
+func (registry *callRegistry) transfer(stayUid string, fromUid string, toUid string, kind sessionsservice.TransferKind, date time.Time) {
+	c := registry.callOf(stayUid)
+	if c == nil {
+		return
+	}
+	if leg := c.leg(toUid); leg != nil && registry.callOf(toUid) == c {
+		leg.role = sessionsservice.LegRole_LEG_TRANSFER_TARGET
+	}
+	c.record(sessionsservice.CallChange_TRANSFERRED, date, fromUid, toUid, kind.String())
+}
+
+// End the leg, the call being forgotten once all its legs ended
+func (registry *callRegistry) endLeg(uid string, date time.Time, cause string) {
+	c := registry.callOf(uid)
+	if c == nil {
+		return
+	}
+	leg := c.leg(uid)
+	if !leg.dateEnd.IsZero() {
+		return
+	}
+	if date.IsZero() {
+		date = time.Now()
+	}
+	leg.dateEnd = date
+	leg.callState = "HANGUP"
+	c.record(sessionsservice.CallChange_LEG_ENDED, date, uid, leg.bridgedUid, cause)
+	if c.ended() {
+		registry.removeCall(c)
+	}
+}
+
+// Move the leg into the call of the conference, the call it leaves keeping the trace of it
+func (registry *callRegistry) joinConference(uid string, name string, date time.Time) {
+	id := conferenceCallPrefix + name
+	conference := registry.byId[id]
+	if conference == nil {
+		conference = registry.newCall(id)
+	}
+	previous := registry.callOf(uid)
+	if previous == conference {
+		return
+	}
+	if previous != nil {
+		previous.record(sessionsservice.CallChange_CONFERENCE_JOINED, date, uid, "", name)
+		leg := previous.leg(uid)
+		for i := range previous.legs {
+			if previous.legs[i] == leg {
+				previous.legs = append(previous.legs[:i], previous.legs[i+1:]...)
+				break
+			}
+		}
+		delete(registry.byUid, uid)
+		if len(previous.legs) == 0 || previous.ended() {
+			registry.removeCall(previous)
+		}
+		leg.role = sessionsservice.LegRole_LEG_CONFERENCE_MEMBER
+		leg.bridgedUid = ""
+		conference.legs = append(conference.legs, leg)
+		registry.byUid[uid] = conference
+	} else {
+		registry.addLeg(conference, uid, sessionsservice.LegRole_LEG_CONFERENCE_MEMBER, date)
+	}
+	conference.record(sessionsservice.CallChange_CONFERENCE_JOINED, date, uid, "", name)
+}
+
+func (registry *callRegistry) leaveConference(uid string, name string, date time.Time) {
+	if c := registry.byId[conferenceCallPrefix+name]; c != nil && registry.callOf(uid) == c {
+		c.record(sessionsservice.CallChange_CONFERENCE_LEFT, date, uid, "", name)
+	}
+}
+
+// End the legs of a session removed without their channels being destroyed (reaper, reconciliation)
+func endSessionLegs(session *sessionsservice.Session, reason string) {
+	for _, uid := range []string{session.CallerUid, session.CalleeUid} {
+		activeCalls.endLeg(uid, time.Now(), reason)
+	}
+}
+
+// Called when a member joins or leaves a conference on freeswitch
+func conferenceMember(eventStr string, connIdx int) {
+	activeSessions.Lock()
+	defer activeSessions.Unlock()
+	var event events.Event = events.CreateEvent(eventStr)
+	if event.UniqueId == "" || event.ConferenceName == "" {
+		return
+	}
+	log.Debugf("BEFORE EVENT : %+v", event)
+	date := event.EventDate
+	if date.IsZero() {
+		date = time.Now()
+	}
+	switch event.ConferenceAction {
+	case "add-member":
+		activeCalls.joinConference(event.UniqueId, event.ConferenceName, date)
+		if leg := activeCalls.callOf(event.UniqueId).leg(event.UniqueId); leg.node == "" && connIdx < len(fsStatus) {
+			leg.node = fsStatus[connIdx].host
+		}
+	case "del-member":
+		activeCalls.leaveConference(event.UniqueId, event.ConferenceName, date)
+	}
+}
+
+func timestampOrNil(date time.Time) *timestamppb.Timestamp {
+	if date.IsZero() {
+		return nil
+	}
+	return timestamppb.New(date)
+}
+
+func (c *call) toCallCopy() *sessionsservice.Call {
+	callCopy := &sessionsservice.Call{CallId: c.id}
+	for _, leg := range c.legs {
+		callCopy.Legs = append(callCopy.Legs, &sessionsservice.Leg{
+			Uid:        leg.uid,
+			Role:       leg.role,
+			Num:        leg.num,
+			Node:       leg.node,
+			DateStart:  timestampOrNil(leg.dateStart),
+			DateEnd:    timestampOrNil(leg.dateEnd),
+			CallState:  leg.callState,
+			BridgedUid: leg.bridgedUid,
+		})
+	}
+	for _, entry := range c.history {
+		callCopy.History = append(callCopy.History, &sessionsservice.CallHistoryEntry{
+			Change:   entry.change,
+			Date:     timestampOrNil(entry.date),
+			Uid:      entry.uid,
+			OtherUid: entry.otherUid,
+			Detail:   entry.detail,
+		})
+	}
+	seen := make(map[string]bool)
+	for _, leg := range c.legs {
+		if session, _, found := activeSessions.Get(leg.uid, "", false, true); found && !seen[sessionUids(session)] {
+			seen[sessionUids(session)] = true
+			callCopy.Sessions = append(callCopy.Sessions, sessionsservice.SessionToSessionsService(session))
+		}
+	}
+	return callCopy
+}
+
+// Used via GRCP to get one call with all its legs, found by its id or by the uid of one of its legs
+func (s *server) GetCall(ctx context.Context, in *sessionsservice.GetCallRequest) (*sessionsservice.Call, error) {
+	log.Debugf("Received:GetCall : %s / %s", in.GetCallId(), in.GetUid())
+	if in.GetCallId() == "" && in.GetUid() == "" {
+		return nil, status.Error(codes.InvalidArgument, "callId or uid is required")
+	}
+	activeSessions.Lock()
+	defer activeSessions.Unlock()
+	c := activeCalls.byId[in.GetCallId()]
+	if c == nil {
+		c = activeCalls.callOf(in.GetUid())
+	}
+	if c == nil {
+		return nil, status.Errorf(codes.NotFound, "no call for callId %q / uid %q", in.GetCallId(), in.GetUid())
+	}
+	return c.toCallCopy(), nil
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fetristan/tlc_sessions/sessionsservice"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func legRoles(c *call) map[string]sessionsservice.LegRole {
+	roles := make(map[string]sessionsservice.LegRole)
+	for _, leg := range c.legs {
+		roles[leg.uid] = leg.role
+	}
+	return roles
+}
+
+func TestCallRegistryRingGroup(t *testing.T) {
+	registry := newCallRegistry()
+	now := time.Now()
+	id := registry.trackSession(&sessionsservice.Session{CallerUid: "a", DateStart: now})
+	registry.trackSession(&sessionsservice.Session{CallerUid: "b", CalleeUid: "a", DateStart: now})
+	if got := registry.trackSession(&sessionsservice.Session{CallerUid: "c", CalleeUid: "a", DateStart: now}); got != id || id != "a" {
+		t.Fatalf("call ids = %q / %q, want a", id, got)
+	}
+	c := registry.byId["a"]
+	roles := legRoles(c)
+	if roles["a"] != sessionsservice.LegRole_LEG_ORIGINATOR || roles["b"] != sessionsservice.LegRole_LEG_GROUP_MEMBER || roles["c"] != sessionsservice.LegRole_LEG_GROUP_MEMBER {
+		t.Fatalf("roles = %v", roles)
+	}
+
+	registry.bridge("a", "c", now)
+	registry.endLeg("b", now, "LOSE_RACE")
+	if roles := legRoles(c); roles["c"] != sessionsservice.LegRole_LEG_DESTINATION || c.leg("a").bridgedUid != "c" {
+		t.Errorf("after bridge roles = %v", roles)
+	}
+	registry.endLeg("a", now, "NORMAL_CLEARING")
+	registry.endLeg("c", now, "NORMAL_CLEARING")
+	if len(registry.byId) != 0 || len(registry.byUid) != 0 {
+		t.Errorf("ended call kept : %v / %v", registry.byId, registry.byUid)
+	}
+	var changes []sessionsservice.CallChange
+	for _, entry := range c.history {
+		changes = append(changes, entry.change)
+	}
+	if len(changes) != 7 || changes[3] != sessionsservice.CallChange_BRIDGED || changes[6] != sessionsservice.CallChange_LEG_ENDED {
+		t.Errorf("history = %v", changes)
+	}
+}
+
+func TestCallRegistryMergeAndConference(t *testing.T) {
+	registry := newCallRegistry()
+	now := time.Now()
+	registry.trackSession(&sessionsservice.Session{CallerUid: "a", CalleeUid: "b", DateStart: now})
+	registry.trackSession(&sessionsservice.Session{CallerUid: "c", CalleeUid: "d", DateStart: now})
+	if id := registry.trackSession(&sessionsservice.Session{CallerUid: "b", CalleeUid: "c", DateStart: now}); id != "a" || len(registry.byId) != 1 || len(registry.byId["a"].legs) != 4 {
+		t.Fatalf("merged call = %q / %v", id, registry.byId)
+	}
+
+	registry.joinConference("a", "room", now)
+	registry.joinConference("e", "room", now)
+	conference := registry.byId[conferenceCallPrefix+"room"]
+	if conference == nil || len(conference.legs) != 2 || registry.callOf("a") != conference || len(registry.byId["a"].legs) != 3 {
+		t.Fatalf("conference = %+v", conference)
+	}
+	if id := registry.trackSession(&sessionsservice.Session{CallerUid: "a", CalleeUid: "b", DateStart: now}); id != conferenceCallPrefix+"room" || registry.callOf("b").id != "a" {
+		t.Errorf("conference merged with the call it left : %q", id)
+	}
+}
+
+func TestGetCall(t *testing.T) {
+	previous := activeCalls
+	activeCalls = newCallRegistry()
+	defer func() { activeCalls = previous }()
+	setTestSessions(t, []sessionsservice.Session{{CallerUid: "a", CalleeUid: "b"}})
+	activeSessions.Lock()
+	activeCalls.trackSession(&sessionsservice.Session{CallerUid: "a", CalleeUid: "b"})
+	activeSessions.Unlock()
+	s := &server{}
+
+	reply, err := s.GetCall(context.Background(), &sessionsservice.GetCallRequest{Uid: "b"})
+	if err != nil || reply.GetCallId() != "a" || len(reply.GetLegs()) != 2 || len(reply.GetSessions()) != 1 {
+		t.Fatalf("GetCall = %+v, %v", reply, err)
+	}
+	if _, err := s.GetCall(context.Background(), &sessionsservice.GetCallRequest{CallId: "missing"}); status.Code(err) != codes.NotFound {
+		t.Errorf("missing call : %v", err)
+	}
+	if _, err := s.GetCall(context.Background(), &sessionsservice.GetCallRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("empty request : %v", err)
+	}
+}
@@ -0,0 +1,252 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fetristan/tlc_dispatcher/message"
+	events "github.com/fetristan/tlc_events"
+	"github.com/fetristan/tlc_sessions/sessionsservice"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// Number of CDR waiting to be written before the new ones are dropped
+const cdrQueueSize = 1024
+
+// Call detail record written when a session ends
+type Cdr struct {
+	CallerUid            string    `json:"caller_uid"`
+	CalleeUid            string    `json:"callee_uid"`
+	OriginalCallerNum    string    `json:"original_caller_num"`
+	OriginalCalleeNum    string    `json:"original_callee_num"`
+	CallerNum            string    `json:"caller_num"`
+	CalleeNum            string    `json:"callee_num"`
+	CallDirection        string    `json:"call_direction"`
+	FsDirection          string    `json:"fs_direction"`
+	HangupSide           string    `json:"hangup_side"`
+	HangupCause          string    `json:"hangup_cause"`
+	SipHangupDisposition string    `json:"sip_hangup_disposition"`
+	DateStart            time.Time `json:"date_start"`
+	DateRing             time.Time `json:"date_ring"`
+	DateCon              time.Time `json:"date_con"`
+	DateEnd              time.Time `json:"date_end"`
+	Duration             int64     `json:"duration"`
+	RingDuration         int64     `json:"ring_duration"`
+	Billsec              int64     `json:"billsec"`
+	HoldTime             int64     `json:"hold_time"`
+	HoldCount            int32     `json:"hold_count"`
+}
+
+// Destination of the CDR
+type CdrSink interface {
+	WriteCdr(cdr *Cdr) error
+}
+
+var cdrQueue chan *Cdr
+var cdrWriterDone chan struct{}
+
+// Guards cdrQueue against the handlers still running when the shutdown closes it
+var cdrQueueState struct {
+	mutex  sync.Mutex
+	closed bool
+}
+
+// Build the CDR of a session ended by event, its hangup being already set
+func newCdr(session *sessionsservice.Session, event events.Event) *Cdr {
+	cdr := &Cdr{
+		CallerUid:            session.CallerUid,
+		CalleeUid:            session.CalleeUid,
+		OriginalCallerNum:    session.OriginalCallerNum,
+		OriginalCalleeNum:    session.OriginalCalleeNum,
+		CallerNum:            session.CallerNum,
+		CalleeNum:            session.CalleeNum,
+		CallDirection:        session.CallDirection,
+		FsDirection:          session.FsDirection,
+		HangupSide:           session.HangupSide,
+		HangupCause:          session.HangupReason,
+		SipHangupDisposition: event.SipHangupDisposition,
+		DateStart:            session.DateStart,
+		DateRing:             session.DateRing,
+		DateCon:              session.DateCon,
+		DateEnd:              session.DateEnd,
+		HoldTime:             holdSeconds(session, session.DateEnd),
+		HoldCount:            session.HoldCount,
+	}
+	if !cdr.DateStart.IsZero() {
+		cdr.Duration = secondsBetween(cdr.DateStart, cdr.DateEnd)
+	}
+	if !cdr.DateRing.IsZero() {
+		if !cdr.DateCon.IsZero() {
+			cdr.RingDuration = secondsBetween(cdr.DateRing, cdr.DateCon)
+		} else {
+			cdr.RingDuration = secondsBetween(cdr.DateRing, cdr.DateEnd)
+		}
+	}
+	if !cdr.DateCon.IsZero() {
+		cdr.Billsec = secondsBetween(cdr.DateCon, cdr.DateEnd)
+	}
+	return cdr
+}
+
+func secondsBetween(from time.Time, to time.Time) int64 {
+	if to.Before(from) {
+		return 0
+	}
+	return int64(to.Sub(from).Seconds())
+}
+
+// Start the writer of the CDR, nothing is written if no sink is configured
+func startCdrWriter(config *Config) error {
+	sink, err := newCdrSink(config)
+	if err != nil || sink == nil {
+		return err
+	}
+	cdrQueue = make(chan *Cdr, cdrQueueSize)
+	cdrWriterDone = make(chan struct{})
+	go func() {
+		defer close(cdrWriterDone)
+		for cdr := range cdrQueue {
+			if err := sink.WriteCdr(cdr); err != nil {
+				log.Errorf("CDR : WRITE ERROR %s / %s : %s", cdr.CallerUid, cdr.CalleeUid, err)
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop the writer once the queued CDR are written or ctx is done, no CDR must be queued after
+func stopCdrWriter(ctx context.Context) error {
+	if cdrQueue == nil {
+		return nil
+	}
+	cdrQueueState.mutex.Lock()
+	if !cdrQueueState.closed {
+		cdrQueueState.closed = true
+		close(cdrQueue)
+	}
+	cdrQueueState.mutex.Unlock()
+	return waitUntil(ctx, func() { <-cdrWriterDone })
+}
+
+// Queue a CDR without blocking the event handlers
+func writeCdr(cdr *Cdr) {
+	if cdrQueue == nil {
+		return
+	}
+	cdrQueueState.mutex.Lock()
+	defer cdrQueueState.mutex.Unlock()
+	if cdrQueueState.closed {
+		log.Errorf("CDR : WRITER STOPPED, DROPPED %s / %s", cdr.CallerUid, cdr.CalleeUid)
+		return
+	}
+	select {
+	case cdrQueue <- cdr:
+	default:
+		log.Errorf("CDR : QUEUE FULL, DROPPED %s / %s", cdr.CallerUid, cdr.CalleeUid)
+	}
+}
+
+func newCdrSink(config *Config) (CdrSink, error) {
+	switch config.Cdr.Sink {
+	case "":
+		return nil, nil
+	case "mysql":
+		return &mysqlCdrSink{table: config.Cdr.Table, holdColumns: config.Cdr.HoldColumns}, nil
+	case "file":
+		file, err := os.OpenFile(config.Cdr.File, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+		if err != nil {
+			return nil, err
+		}
+		return &fileCdrSink{file: file}, nil
+	case "dispatcher":
+		conn, err := grpc.Dial(config.GrcpDispatcher.Host+":"+config.GrcpDispatcher.Port, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			return nil, err
+		}
+		return &dispatcherCdrSink{client: message.NewMessageServiceClient(conn), urlApi: config.Cdr.UrlApi, timeout: time.Duration(config.GrcpDispatcher.Timeout) * time.Second}, nil
+	default:
+		return nil, fmt.Errorf("unknown cdr sink %q", config.Cdr.Sink)
+	}
+}
+
+// Insert the CDR into a table of the database
+type mysqlCdrSink struct {
+	table       string
+	holdColumns bool
+}
+
+func (sink *mysqlCdrSink) WriteCdr(cdr *Cdr) error {
+	if db == nil {
+		return errDbNotReady
+	}
+	query, args := sink.insert(cdr)
+	_, err := db.conn.Exec(query, args...)
+	return err
+}
+
+// Insert of the CDR, the hold columns being written only if the table has them
+func (sink *mysqlCdrSink) insert(cdr *Cdr) (string, []interface{}) {
+	columns := "caller_uid, callee_uid, original_caller_num, original_callee_num, caller_num, callee_num, call_direction, fs_direction, hangup_side, hangup_cause, sip_hangup_disposition, date_start, date_ring, date_con, date_end, duration, ring_duration, billsec"
+	args := []interface{}{cdr.CallerUid, cdr.CalleeUid, cdr.OriginalCallerNum, cdr.OriginalCalleeNum, cdr.CallerNum, cdr.CalleeNum, cdr.CallDirection, cdr.FsDirection, cdr.HangupSide, cdr.HangupCause, cdr.SipHangupDisposition,
+		nullTime(cdr.DateStart), nullTime(cdr.DateRing), nullTime(cdr.DateCon), nullTime(cdr.DateEnd), cdr.Duration, cdr.RingDuration, cdr.Billsec}
+	if sink.holdColumns {
+		columns += ", hold_time, hold_count"
+		args = append(args, cdr.HoldTime, cdr.HoldCount)
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(args)), ", ")
+	return "INSERT INTO " + sink.table + " (" + columns + ") VALUES (" + placeholders + ")", args
+}
+
+func nullTime(t time.Time) interface{} {
+	if t.IsZero() {
+		return nil
+	}
+	return t
+}
+
+// Append the CDR as one json per line
+type fileCdrSink struct {
+	mutex sync.Mutex
+	file  *os.File
+}
+
+func (sink *fileCdrSink) WriteCdr(cdr *Cdr) error {
+	jsonStr, err := json.Marshal(cdr)
+	if err != nil {
+		return err
+	}
+	sink.mutex.Lock()
+	defer sink.mutex.Unlock()
+	_, err = sink.file.Write(append(jsonStr, '\n'))
+	return err
+}
+
+// Post the CDR as json through the dispatcher
+type dispatcherCdrSink struct {
+	client  message.MessageServiceClient
+	urlApi  string
+	timeout time.Duration
+}
+
+func (sink *dispatcherCdrSink) WriteCdr(cdr *Cdr) error {
+	jsonStr, err := json.Marshal(cdr)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), sink.timeout)
+	defer cancel()
+	_, err = sink.client.New(ctx, &message.MessageRequest{
+		Method:   "POST",
+		Request:  sink.urlApi,
+		Priority: 1,
+		Timeout:  1,
+		Data:     string(jsonStr),
+	})
+	return err
+}
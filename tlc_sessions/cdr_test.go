@@ -0,0 +1,47 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	events "github.com/fetristan/tlc_events"
+	"github.com/fetristan/tlc_sessions/sessionsservice"
+)
+
+func TestNewCdrDurations(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+	at := func(seconds int) time.Time { return start.Add(time.Duration(seconds) * time.Second) }
+	tests := []struct {
+		name                            string
+		session                         sessionsservice.Session
+		duration, ringDuration, billsec int64
+	}{
+		{"answered", sessionsservice.Session{DateStart: start, DateRing: at(2), DateCon: at(10), DateEnd: at(70)}, 70, 8, 60},
+		{"not answered", sessionsservice.Session{DateStart: start, DateRing: at(2), DateEnd: at(32)}, 32, 30, 0},
+		{"never rang", sessionsservice.Session{DateStart: start, DateEnd: at(5)}, 5, 0, 0},
+		{"no start", sessionsservice.Session{DateCon: at(10), DateEnd: at(20)}, 0, 0, 10},
+		{"end before start", sessionsservice.Session{DateStart: at(10), DateRing: at(10), DateCon: at(10), DateEnd: start}, 0, 0, 0},
+	}
+	for _, test := range tests {
+		cdr := newCdr(&test.session, events.Event{SipHangupDisposition: "recv_bye"})
+		if cdr.Duration != test.duration || cdr.RingDuration != test.ringDuration || cdr.Billsec != test.billsec {
+			t.Errorf("%s : duration %d / ring %d / billsec %d, want %d / %d / %d", test.name, cdr.Duration, cdr.RingDuration, cdr.Billsec, test.duration, test.ringDuration, test.billsec)
+		}
+		if cdr.SipHangupDisposition != "recv_bye" {
+			t.Errorf("%s : sip hangup disposition %q", test.name, cdr.SipHangupDisposition)
+		}
+	}
+}
+
+func TestMysqlCdrInsertHoldColumns(t *testing.T) {
+	cdr := &Cdr{CallerUid: "a", HoldTime: 12, HoldCount: 2}
+	query, args := (&mysqlCdrSink{table: "cdr"}).insert(cdr)
+	if strings.Contains(query, "hold_time") || len(args) != 18 || strings.Count(query, "?") != len(args) {
+		t.Errorf("insert without hold columns = %q / %d args", query, len(args))
+	}
+	query, args = (&mysqlCdrSink{table: "cdr", holdColumns: true}).insert(cdr)
+	if !strings.Contains(query, "billsec, hold_time, hold_count)") || len(args) != 20 || strings.Count(query, "?") != len(args) || args[18] != int64(12) {
+		t.Errorf("insert with hold columns = %q / %v", query, args)
+	}
+}
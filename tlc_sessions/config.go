@@ -8,7 +8,12 @@ import (
 
 type Config struct {
 	Sessions struct {
-		Cycle int `yaml:"cycle"`
+		Cycle             int    `yaml:"cycle"`
+		EndedGrace        int    `yaml:"ended_grace"`
+		ShutdownTimeout   int    `yaml:"shutdown_timeout"`
+		ReconcileInterval int    `yaml:"reconcile_interval"`
+		ReapAfter         int    `yaml:"reap_after"`
+		ReapMode          string `yaml:"reap_mode"`
 	} `yaml:"sessions"`
 	Freeswitch []struct {
 		Host        string `yaml:"host"`
@@ -18,22 +23,49 @@ type Config struct {
 		RetryNumber int    `yaml:"retry_number"`
 	} `yaml:"freeswitch"`
 	Database struct {
-		Host   string `yaml:"host"`
-		Port   string `yaml:"port"`
-		User   string `yaml:"user"`
-		Pass   string `yaml:"pass"`
-		Dbname string `yaml:"dbname"`
+		Host            string `yaml:"host"`
+		Port            string `yaml:"port"`
+		User            string `yaml:"user"`
+		Pass            string `yaml:"pass"`
+		Dbname          string `yaml:"dbname"`
+		MaxOpenConns    int    `yaml:"max_open_conns"`
+		MaxIdleConns    int    `yaml:"max_idle_conns"`
+		ConnMaxLifetime int    `yaml:"conn_max_lifetime"`
+		Timeout         int    `yaml:"timeout"`
 	} `yaml:"database"`
-	Redis struct {
-		Host   string `yaml:"host"`
-		Port   string `yaml:"port"`
-		User   string `yaml:"user"`
-		Pass   string `yaml:"pass"`
-		Dbname int    `yaml:"db"`
+	NumCache struct {
+		Ttl         int `yaml:"ttl"`
+		NegativeTtl int `yaml:"negative_ttl"`
+		Size        int `yaml:"size"`
+	} `yaml:"num_cache"`
+	Classification []ClassificationRule `yaml:"classification"`
+	Redis          struct {
+		Host       string `yaml:"host"`
+		Port       string `yaml:"port"`
+		User       string `yaml:"user"`
+		Pass       string `yaml:"pass"`
+		Dbname     int    `yaml:"db"`
+		SessionTtl int    `yaml:"session_ttl"`
 	} `yaml:"redis"`
 	GrcpListener struct {
 		Port int `yaml:"port"`
 	} `yaml:"grcp_listener"`
+	Metrics struct {
+		Port int `yaml:"port"`
+	} `yaml:"metrics"`
+	GrcpDispatcher struct {
+		Host    string `yaml:"host"`
+		Port    string `yaml:"port"`
+		Timeout int    `yaml:"timeout"`
+	} `yaml:"grcp_dispatcher"`
+	Cdr struct {
+		Sink   string `yaml:"sink"`
+		Table  string `yaml:"table"`
+		File   string `yaml:"file"`
+		UrlApi string `yaml:"url_api"`
+		// Write hold_time and hold_count, the table having these columns
+		HoldColumns bool `yaml:"hold_columns"`
+	} `yaml:"cdr"`
 }
 
 func readConf(filename string) (*Config, error) {
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"strings"
+	"time"
+
+	events "github.com/fetristan/tlc_events"
+	"github.com/fetristan/tlc_sessions/sessionsservice"
+)
+
+// Sessions of the ended calls, kept during the grace period to let GetSessionCopyService read their final state
+var endedSessions = sessionsservice.NewStore()
+
+// Remove the session of a call ended by event, record why and by who it ended, write its CDR and keep it as ended
+func endSession(uniqueId string, otherId string, event events.Event) {
+	session, found := activeSessions.Remove(uniqueId, otherId)
+	if !found {
+		return
+	}
+	finishSession(session, event)
+}
+
+// Record why and by who the session removed from the active ones ended, write its CDR and keep it as ended
+func finishSession(session *sessionsservice.Session, event events.Event) {
+	setSessionHangup(session, event)
+	delRedisDatabaseSession(session)
+	publishSessionChange(sessionsservice.SessionChange_REMOVED, event.EventName, session)
+	writeCdr(newCdr(session, event))
+	endedSessions.Lock()
+	defer endedSessions.Unlock()
+	endedSessions.Add(*session)
+}
+
+func setSessionHangup(session *sessionsservice.Session, event events.Event) {
+	session.DateEnd = event.HangupTime
+	if session.DateEnd.IsZero() {
+		session.DateEnd = event.EventDate
+	}
+	session.HangupReason = event.HangupCause
+	if session.HangupReason == "" {
+		session.HangupReason = event.LastBridgehangup
+	}
+	session.HangupSide = hangupSide(session, event)
+	endHold(session, session.DateEnd)
+	session.CallState = "HANGUP"
+}
+
+// Side (caller or callee) which hung up, the event being sent by one of the legs of the session
+func hangupSide(session *sessionsservice.Session, event events.Event) string {
+	side, otherSide := "caller", "callee"
+	if event.UniqueId == session.CalleeUid {
+		side, otherSide = "callee", "caller"
+	}
+	if strings.HasPrefix(event.SipHangupDisposition, "recv_") {
+		return side
+	} else if strings.HasPrefix(event.SipHangupDisposition, "send_") {
+		return otherSide
+	} else if event.LastBridgehangup != "" {
+		return otherSide
+	} else if event.EventName == "CHANNEL_DESTROY" {
+		return side
+	}
+	return ""
+}
+
+func getEndedSession(callerUid string, calleeUid string, exactly bool, onlyOneUid bool) (*sessionsservice.Session, bool) {
+	endedSessions.Lock()
+	defer endedSessions.Unlock()
+	session, _, found := endedSessions.Get(callerUid, calleeUid, exactly, onlyOneUid)
+	return session, found
+}
+
+// Forget the ended sessions older than the grace period
+func purgeEndedSessions(grace time.Duration) {
+	endedSessions.Lock()
+	defer endedSessions.Unlock()
+	limit := time.Now().Add(-grace)
+	removed := endedSessions.RemoveWhere(func(session *sessionsservice.Session) bool {
+		return session.DateEnd.Before(limit)
+	})
+	if len(removed) > 0 {
+		log.Debugf("tlc_sessions ended sessions purged : %d", len(removed))
+	}
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	events "github.com/fetristan/tlc_events"
+	"github.com/fetristan/tlc_sessions/sessionsservice"
+)
+
+func TestHangupSide(t *testing.T) {
+	session := &sessionsservice.Session{CallerUid: "a", CalleeUid: "b"}
+	tests := []struct {
+		name  string
+		event events.Event
+		want  string
+	}{
+		{"caller sent bye", events.Event{UniqueId: "a", SipHangupDisposition: "recv_bye"}, "caller"},
+		{"callee sent bye", events.Event{UniqueId: "b", SipHangupDisposition: "recv_bye"}, "callee"},
+		{"bye sent to the caller", events.Event{UniqueId: "a", SipHangupDisposition: "send_bye"}, "callee"},
+		{"bridged leg hung up", events.Event{UniqueId: "b", LastBridgehangup: "NORMAL_CLEARING"}, "caller"},
+		{"caller destroyed", events.Event{UniqueId: "a", EventName: "CHANNEL_DESTROY"}, "caller"},
+		{"callee destroyed", events.Event{UniqueId: "b", EventName: "CHANNEL_DESTROY"}, "callee"},
+		{"ended by the system", events.Event{UniqueId: "a", EventName: "CHANNEL_UNBRIDGE"}, ""},
+	}
+	for _, test := range tests {
+		if got := hangupSide(session, test.event); got != test.want {
+			t.Errorf("%s : hangupSide = %q, want %q", test.name, got, test.want)
+		}
+	}
+}
+
+func TestPurgeEndedSessions(t *testing.T) {
+	previous := endedSessions
+	endedSessions = sessionsservice.NewStore()
+	defer func() { endedSessions = previous }()
+	endedSessions.Set([]sessionsservice.Session{
+		{CallerUid: "old", DateEnd: time.Now().Add(-time.Minute)},
+		{CallerUid: "recent", DateEnd: time.Now()},
+	})
+	purgeEndedSessions(30 * time.Second)
+	if _, found := getEndedSession("old", "", false, true); found {
+		t.Errorf("session ended before the grace period kept")
+	}
+	if _, found := getEndedSession("recent", "", false, true); !found {
+		t.Errorf("session ended during the grace period purged")
+	}
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"strconv"
+	"time"
+
+	events "github.com/fetristan/tlc_events"
+	"github.com/fetristan/tlc_sessions/sessionsservice"
+)
+
+// Start a hold of the session, a session already on hold keeping its first hold start
+func startHold(session *sessionsservice.Session, event events.Event) {
+	if !session.HoldStart.IsZero() {
+		return
+	}
+	session.HoldStart = event.LastHoldTime
+	if session.HoldStart.IsZero() {
+		session.HoldStart = event.EventDate
+	}
+	if session.HoldStart.IsZero() {
+		session.HoldStart = time.Now()
+	}
+	session.HoldCount++
+}
+
+// End the current hold of the session at date, adding its duration to the accumulated hold time
+func endHold(session *sessionsservice.Session, date time.Time) {
+	if session.HoldStart.IsZero() {
+		return
+	}
+	if date.IsZero() {
+		date = time.Now()
+	}
+	session.HoldAccum += secondsBetween(session.HoldStart, date)
+	session.HoldStart = time.Time{}
+}
+
+// End the current hold at unhold, freeswitch giving the hold time accumulated by the channel in microseconds
+func endHoldFromEvent(session *sessionsservice.Session, event events.Event) {
+	endHold(session, event.EventDate)
+	if accum, err := strconv.ParseInt(event.AccumHold, 10, 64); err == nil && accum/1000000 > session.HoldAccum {
+		session.HoldAccum = accum / 1000000
+	}
+}
+
+// Seconds spent on hold by the session until date, the current hold included
+func holdSeconds(session *sessionsservice.Session, date time.Time) int64 {
+	if session.HoldStart.IsZero() {
+		return session.HoldAccum
+	}
+	return session.HoldAccum + secondsBetween(session.HoldStart, date)
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	events "github.com/fetristan/tlc_events"
+	"github.com/fetristan/tlc_sessions/sessionsservice"
+)
+
+func TestHoldAccounting(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+	var session sessionsservice.Session
+	startHold(&session, events.Event{EventDate: start})
+	startHold(&session, events.Event{EventDate: start.Add(5 * time.Second)})
+	if !session.HoldStart.Equal(start) || session.HoldCount != 1 {
+		t.Fatalf("after holds : start %v, count %d", session.HoldStart, session.HoldCount)
+	}
+	if got := holdSeconds(&session, start.Add(7*time.Second)); got != 7 {
+		t.Errorf("holdSeconds during hold = %d, want 7", got)
+	}
+	endHoldFromEvent(&session, events.Event{EventDate: start.Add(10 * time.Second)})
+	if !session.HoldStart.IsZero() || session.HoldAccum != 10 {
+		t.Fatalf("after unhold : start %v, accum %d", session.HoldStart, session.HoldAccum)
+	}
+
+	// The hold time accumulated by freeswitch wins when the session missed a part of it
+	startHold(&session, events.Event{LastHoldTime: start.Add(20 * time.Second), EventDate: start.Add(21 * time.Second)})
+	endHoldFromEvent(&session, events.Event{EventDate: start.Add(22 * time.Second), AccumHold: "15000000"})
+	if session.HoldAccum != 15 || session.HoldCount != 2 {
+		t.Errorf("after second unhold : accum %d, count %d", session.HoldAccum, session.HoldCount)
+	}
+
+	// A session ending on hold counts its current hold in its CDR
+	startHold(&session, events.Event{EventDate: start.Add(30 * time.Second)})
+	setSessionHangup(&session, events.Event{HangupTime: start.Add(33 * time.Second)})
+	if cdr := newCdr(&session, events.Event{}); cdr.HoldTime != 18 || cdr.HoldCount != 3 {
+		t.Errorf("cdr hold = %d / %d, want 18 / 3", cdr.HoldTime, cdr.HoldCount)
+	}
+}
@@ -0,0 +1,191 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fetristan/tlc_sessions/sessionsservice"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// Page size of ListSessions if none is asked, and the largest one allowed
+const (
+	defaultListPageSize = 100
+	maxListPageSize     = 1000
+)
+
+func callerTypeIn(callerType sessionsservice.CallerType, callerTypes []sessionsservice.CallerType) bool {
+	if len(callerTypes) == 0 {
+		return true
+	}
+	for _, oneType := range callerTypes {
+		if oneType == callerType {
+			return true
+		}
+	}
+	return false
+}
+
+func stringIn(value string, values []string) bool {
+	if len(values) == 0 {
+		return true
+	}
+	for _, oneValue := range values {
+		if oneValue == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Whether the session matches every filter of the request
+func matchSessionFilters(session *sessionsservice.Session, in *sessionsservice.ListSessionsRequest, now time.Time) bool {
+	if in.GetPole() != "" && session.Pole != in.GetPole() {
+		return false
+	}
+	if !stringIn(session.CallState, in.GetCallStates()) {
+		return false
+	}
+	if in.GetCallDirection() != "" && session.CallDirection != in.GetCallDirection() {
+		return false
+	}
+	if in.GetFsDirection() != "" && session.FsDirection != in.GetFsDirection() {
+		return false
+	}
+	if !strings.HasPrefix(session.CallerNum, in.GetCallerNumPrefix()) || !strings.HasPrefix(session.CalleeNum, in.GetCalleeNumPrefix()) {
+		return false
+	}
+	if !callerTypeIn(session.CallerType, in.GetCallerTypes()) || !callerTypeIn(session.CalleeType, in.GetCalleeTypes()) {
+		return false
+	}
+	if minDuration := in.GetMinDuration().AsDuration(); minDuration > 0 && (session.DateStart.IsZero() || now.Sub(session.DateStart) < minDuration) {
+		return false
+	}
+	return true
+}
+
+// Sessions are listed by start date then uids, the page token being the key of the last session sent
+type listKey struct {
+	dateStart int64
+	callerUid string
+	calleeUid string
+}
+
+func sessionListKey(session *sessionsservice.Session) listKey {
+	return listKey{session.DateStart.UnixNano(), session.CallerUid, session.CalleeUid}
+}
+
+func (key listKey) less(other listKey) bool {
+	if key.dateStart != other.dateStart {
+		return key.dateStart < other.dateStart
+	}
+	if key.callerUid != other.callerUid {
+		return key.callerUid < other.callerUid
+	}
+	return key.calleeUid < other.calleeUid
+}
+
+func encodePageToken(key listKey) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(key.dateStart, 10) + "\x00" + key.callerUid + "\x00" + key.calleeUid))
+}
+
+func decodePageToken(token string) (listKey, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return listKey{}, status.Error(codes.InvalidArgument, "invalid page token")
+	}
+	parts := strings.Split(string(raw), "\x00")
+	if len(parts) != 3 {
+		return listKey{}, status.Error(codes.InvalidArgument, "invalid page token")
+	}
+	dateStart, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return listKey{}, status.Error(codes.InvalidArgument, "invalid page token")
+	}
+	return listKey{dateStart, parts[1], parts[2]}, nil
+}
+
+// Clear the fields of the session copy which are not in the mask, an empty mask keeping them all
+func applyFieldMask(sessionCopy *sessionsservice.SessionCopy, mask *fieldmaskpb.FieldMask) {
+	if len(mask.GetPaths()) == 0 {
+		return
+	}
+	keep := make(map[protoreflect.Name]bool)
+	for _, path := range mask.GetPaths() {
+		keep[protoreflect.Name(path)] = true
+	}
+	message := sessionCopy.ProtoReflect()
+	message.Range(func(field protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		if !keep[field.Name()] {
+			message.Clear(field)
+		}
+		return true
+	})
+}
+
+// Used via GRCP to list the sessions matching filters, one page at a time
+func (s *server) ListSessions(ctx context.Context, in *sessionsservice.ListSessionsRequest) (*sessionsservice.ListSessionsReply, error) {
+	log.Debugf("Received:ListSessions : %+v", in)
+	if in.GetFieldMask() != nil && !in.GetFieldMask().IsValid(&sessionsservice.SessionCopy{}) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid field mask %v", in.GetFieldMask().GetPaths())
+	}
+	if in.GetPageSize() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page size must not be negative")
+	}
+	pageSize := int(in.GetPageSize())
+	if pageSize == 0 {
+		pageSize = defaultListPageSize
+	}
+	if pageSize > maxListPageSize {
+		pageSize = maxListPageSize
+	}
+	var after *listKey
+	if in.GetPageToken() != "" {
+		key, err := decodePageToken(in.GetPageToken())
+		if err != nil {
+			return nil, err
+		}
+		after = &key
+	}
+
+	now := time.Now()
+	var matching []sessionsservice.Session
+	activeSessions.Lock()
+	for _, session := range activeSessions.List() {
+		if matchSessionFilters(&session, in, now) {
+			matching = append(matching, session)
+		}
+	}
+	activeSessions.Unlock()
+	sort.Slice(matching, func(i, j int) bool {
+		return sessionListKey(&matching[i]).less(sessionListKey(&matching[j]))
+	})
+
+	reply := &sessionsservice.ListSessionsReply{TotalSize: int32(len(matching))}
+	start := 0
+	if after != nil {
+		start = sort.Search(len(matching), func(i int) bool {
+			return after.less(sessionListKey(&matching[i]))
+		})
+	}
+	end := start + pageSize
+	if end > len(matching) {
+		end = len(matching)
+	}
+	for i := start; i < end; i++ {
+		sessionCopy := sessionsservice.SessionToSessionsService(&matching[i])
+		applyFieldMask(sessionCopy, in.GetFieldMask())
+		reply.SessionCopy = append(reply.SessionCopy, sessionCopy)
+	}
+	if end < len(matching) {
+		reply.NextPageToken = encodePageToken(sessionListKey(&matching[end-1]))
+	}
+	return reply, nil
+}
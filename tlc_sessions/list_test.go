@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fetristan/tlc_sessions/sessionsservice"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestListSessionsFilters(t *testing.T) {
+	start := time.Now().Add(-time.Hour)
+	setTestSessions(t, []sessionsservice.Session{
+		{CallerUid: "a", CallerNum: "0612", CalleeNum: "1001", Pole: "FR", CallState: "ACTIVE", CalleeType: sessionsservice.CallerType_AGENT, DateStart: start},
+		{CallerUid: "b", CallerNum: "0712", CalleeNum: "1002", Pole: "FR", CallState: "RINGING", DateStart: start.Add(time.Minute)},
+		{CallerUid: "c", CallerNum: "0612", CalleeNum: "1003", Pole: "BE", CallState: "ACTIVE", DateStart: time.Now()},
+	})
+	s := &server{}
+
+	for _, test := range []struct {
+		name string
+		in   *sessionsservice.ListSessionsRequest
+		want []string
+	}{
+		{"all", &sessionsservice.ListSessionsRequest{}, []string{"a", "b", "c"}},
+		{"pole", &sessionsservice.ListSessionsRequest{Pole: "FR"}, []string{"a", "b"}},
+		{"call states", &sessionsservice.ListSessionsRequest{CallStates: []string{"ACTIVE"}}, []string{"a", "c"}},
+		{"number prefix", &sessionsservice.ListSessionsRequest{CallerNumPrefix: "06", CalleeNumPrefix: "100"}, []string{"a", "c"}},
+		{"callee type", &sessionsservice.ListSessionsRequest{CalleeTypes: []sessionsservice.CallerType{sessionsservice.CallerType_AGENT}}, []string{"a"}},
+		{"min duration", &sessionsservice.ListSessionsRequest{MinDuration: durationpb.New(30 * time.Minute)}, []string{"a", "b"}},
+	} {
+		reply, err := s.ListSessions(context.Background(), test.in)
+		if err != nil {
+			t.Fatalf("%s : %v", test.name, err)
+		}
+		var got []string
+		for _, sessionCopy := range reply.GetSessionCopy() {
+			got = append(got, sessionCopy.GetCallerUid())
+		}
+		if len(got) != len(test.want) || int(reply.GetTotalSize()) != len(test.want) {
+			t.Errorf("%s : got %v, want %v", test.name, got, test.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != test.want[i] {
+				t.Errorf("%s : got %v, want %v", test.name, got, test.want)
+				break
+			}
+		}
+	}
+}
+
+func TestListSessionsPages(t *testing.T) {
+	start := time.Now()
+	setTestSessions(t, []sessionsservice.Session{
+		{CallerUid: "c", DateStart: start},
+		{CallerUid: "a", CallerNum: "0612", DateStart: start},
+		{CallerUid: "b", DateStart: start.Add(-time.Second)},
+	})
+	s := &server{}
+	in := &sessionsservice.ListSessionsRequest{PageSize: 2, FieldMask: &fieldmaskpb.FieldMask{Paths: []string{"callerUid"}}}
+
+	first, err := s.ListSessions(context.Background(), in)
+	if err != nil || len(first.GetSessionCopy()) != 2 || first.GetNextPageToken() == "" {
+		t.Fatalf("first page = %+v, %v", first, err)
+	}
+	if first.GetSessionCopy()[0].GetCallerUid() != "b" || first.GetSessionCopy()[1].GetCallerUid() != "a" || first.GetSessionCopy()[1].GetCallerNum() != "" {
+		t.Errorf("first page = %+v", first.GetSessionCopy())
+	}
+	in.PageToken = first.GetNextPageToken()
+	second, err := s.ListSessions(context.Background(), in)
+	if err != nil || len(second.GetSessionCopy()) != 1 || second.GetSessionCopy()[0].GetCallerUid() != "c" || second.GetNextPageToken() != "" {
+		t.Errorf("second page = %+v, %v", second, err)
+	}
+
+	for _, in := range []*sessionsservice.ListSessionsRequest{
+		{PageToken: "not a token"},
+		{FieldMask: &fieldmaskpb.FieldMask{Paths: []string{"unknown"}}},
+		{PageSize: -1},
+	} {
+		if _, err := s.ListSessions(context.Background(), in); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%+v : %v, want InvalidArgument", in, err)
+		}
+	}
+}
@@ -48,3 +48,27 @@ type Livecalls struct {
 	RowCount int             `json:"row_count"`
 	Rows     []LivecallsList `json:"rows"`
 }
+
+type ChannelsList struct {
+	Uuid         string `json:"uuid"`
+	Direction    string `json:"direction"`
+	Created      string `json:"created"`
+	CreatedEpoch string `json:"created_epoch"`
+	Name         string `json:"name"`
+	State        string `json:"state"`
+	CidName      string `json:"cid_name"`
+	CidNum       string `json:"cid_num"`
+	IpAddr       string `json:"ip_addr"`
+	Dest         string `json:"dest"`
+	Application  string `json:"application"`
+	Hostname     string `json:"hostname"`
+	Callstate    string `json:"callstate"`
+	CalleeName   string `json:"callee_name"`
+	CalleeNum    string `json:"callee_num"`
+	CallUuid     string `json:"call_uuid"`
+}
+
+type Channels struct {
+	RowCount int            `json:"row_count"`
+	Rows     []ChannelsList `json:"rows"`
+}
@@ -12,3 +12,9 @@ func logSession(event events.Event, session *sessionsservice.Session, text strin
 	}
 	log.Debugf("%s : %s : %s (%s / %s / %s) (%s:%s)(%s:%s) (%s) : %s -> %s", event.EventDate.Format("2006-01-02 15:04:05.999999 MST"), text, event.EventName, event.EventSubclass, event.ApiCommand, event.ApiCommandArgument, event.CallerNumber, event.CalleeNumber, session.CallerNum, session.CalleeNum, event.RecordId, event.UniqueId, event.OtherId)
 }
+
+// Log and count an event for which no session was found
+func logSessionNotFound(event events.Event, session *sessionsservice.Session) {
+	sessionsNotFound.WithLabelValues(event.EventName).Inc()
+	logSession(event, session, "SESSION NOT FOUND")
+}
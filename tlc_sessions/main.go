@@ -2,11 +2,12 @@ package main
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net"
+	"os/signal"
 	"runtime"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/cgrates/fsock"
@@ -15,15 +16,23 @@ import (
 	"github.com/fetristan/tlc_sessions/sessionsservice"
 	_ "github.com/go-sql-driver/mysql"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 var (
-	log *logger.Logger
-	fs  []*fsock.FSock
-	db  *Db
+	log      *logger.Logger
+	fs       []*fsock.FSock
+	db       *Db
+	numCache *cachedNumRepository
 )
 
+// Sessions of the calls in progress, owned by tlc_sessions
+var activeSessions = sessionsservice.NewStore()
+
 // fibDuration returns successive Fibonacci numbers converted to time.Duration.
 func fibDuration(durationUnit, maxDuration time.Duration) func() time.Duration {
 	a, b := 0, 1
@@ -49,6 +58,10 @@ func init() {
 }
 
 func main() {
+	//Stopped on SIGINT or SIGTERM
+	runCtx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
 	//Config reader
 	config, err := readConf("config.yml")
 	if err != nil {
@@ -64,18 +77,47 @@ func main() {
 		log.Errorf("failed to listen: %v", err)
 	}
 	var opts []grpc.ServerOption
+	opts = append(opts, grpc.UnaryInterceptor(grpcUnaryMetrics), grpc.StreamInterceptor(grpcStreamMetrics))
 	grpcServer := grpc.NewServer(opts...)
 	sessionsservice.RegisterSessionsServiceServer(grpcServer, &server{})
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	healthServer.SetServingStatus(sessionsservice.SessionsService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_NOT_SERVING)
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
 	go grpcServer.Serve(lis)
 	log.Debugf("tlc_session grcp connected : port: %d", config.GrcpListener.Port)
 
+	//Metrics listener
+	startMetricsListener(config.Metrics.Port)
+	log.Debugf("tlc_sessions metrics ready : port: %d", config.Metrics.Port)
+
 	//Database connection
-	db = newDb(config)
+	db, err = newDb(config)
+	if err != nil {
+		log.Errorf("Database error: %s", err)
+	}
+	numCache = newCachedNumRepository(db, time.Duration(config.NumCache.Ttl)*time.Second, time.Duration(config.NumCache.NegativeTtl)*time.Second, config.NumCache.Size)
+	numRepository = numCache
+	log.Debugf("tlc_sessions num cache ready : ttl : %d / negative ttl : %d / size : %d", config.NumCache.Ttl, config.NumCache.NegativeTtl, config.NumCache.Size)
+	rules, err := newClassificationRules(config.Classification)
+	if err != nil {
+		log.Errorf("Classification error, default rules used: %s", err)
+	} else {
+		classificationRules = rules
+	}
 	log.Debugf("tlc_sessions database connected : database host: %s  / database port: %s / database user: %s / database pass: %s / database dbname: %s", config.Database.Host, config.Database.Port, config.Database.User, config.Database.Pass, config.Database.Dbname)
 
+	//CDR writer
+	err = startCdrWriter(config)
+	if err != nil {
+		log.Errorf("CDR error: %s", err)
+	}
+	log.Debugf("tlc_sessions cdr writer ready : sink: %s", config.Cdr.Sink)
+
 	//Redis connection
-	connectToRedisDatabase(config.Redis.Host, config.Redis.Port, config.Redis.Pass, config.Redis.Dbname)
-	log.Debugf("tlc_sessions redis connected : host: %s  / port: %s / pass: %s / db : %d", config.Redis.Host, config.Redis.Port, config.Redis.Pass, config.Redis.Dbname)
+	connectToRedisDatabase(config.Redis.Host, config.Redis.Port, config.Redis.Pass, config.Redis.Dbname, config.Redis.SessionTtl)
+	startRedisWriter()
+	log.Debugf("tlc_sessions redis connected : host: %s  / port: %s / pass: %s / db : %d / session ttl : %d", config.Redis.Host, config.Redis.Port, config.Redis.Pass, config.Redis.Dbname, config.Redis.SessionTtl)
 
 	//Freeswitch event listener routing
 	evFilters := make(map[string][]string)
@@ -95,61 +137,71 @@ func main() {
 	evFilters["Event-Name"] = append(evFilters["Event-Name"], "CUSTOM")
 	//evFilters["Event-Name"] = append(evFilters["Event-Name"], "monitor::ivr_state")
 	evHandlers := map[string][]func(string, int){
-		"CHANNEL_CREATE":   {channelCreate},
-		"CHANNEL_PROGRESS": {channelProgress},
-		"CHANNEL_BRIDGE":   {channelBridge},
-		"CHANNEL_UNBRIDGE": {channelUnbridge},
-		"CHANNEL_DESTROY":  {channelDestroy},
-		"CHANNEL_HOLD":     {channelHold},
-		"CHANNEL_UNHOLD":   {channelUnhold},
-		"CHANNEL_PARK":     {channelPark},
-		"CHANNEL_UNPARK":   {channelUnpark},
-		"RECORD_START":     {recordStart},
-		"PLAYBACK_START":   {playbackStart},
-		"API":              {apiCommand},
+		"CHANNEL_CREATE":             {channelCreate},
+		"CHANNEL_PROGRESS":           {channelProgress},
+		"CHANNEL_BRIDGE":             {channelBridge},
+		"CHANNEL_UNBRIDGE":           {channelUnbridge},
+		"CHANNEL_DESTROY":            {channelDestroy},
+		"CHANNEL_HOLD":               {channelHold},
+		"CHANNEL_UNHOLD":             {channelUnhold},
+		"CHANNEL_PARK":               {channelPark},
+		"CHANNEL_UNPARK":             {channelUnpark},
+		"RECORD_START":               {recordStart},
+		"PLAYBACK_START":             {playbackStart},
+		"API":                        {apiCommand},
+		"CUSTOM conference::maninfo": {conferenceMember},
+		"CUSTOM sofia::transferor":   {transferStart},
+		"CUSTOM sofia::transferee":   {transferStart},
 		//"CUSTOM monitor::ivr_state": {customivrState},
 	}
 
+	newFreeswitchStatus(config)
+	evHandlers = gateHandlers(&eventHandlersGate, trackLastEvent(instrumentHandlers(evHandlers)))
+
 	//Get sessions into redis before connect to freeswitch
 	sessions, errBool := getRedisDatabaseSessions()
-	log.Debugf("tlc_sessions sessions found in redis after restart : %s", sessions)
+	log.Debugf("tlc_sessions sessions found in redis after restart : %d", len(sessions))
 
 	for _, freeswitchConf := range config.Freeswitch {
-		fstmp, err := fsock.NewFSock(freeswitchConf.Host+":"+freeswitchConf.Port, freeswitchConf.Pass, freeswitchConf.RetryNumber, 0, fibDuration, evHandlers, evFilters, nil, 0, true)
+		fstmp, err := fsock.NewFSock(freeswitchConf.Host+":"+freeswitchConf.Port, freeswitchConf.Pass, freeswitchConf.RetryNumber, 0, fibDuration, evHandlers, evFilters, nil, len(fs), true)
 		fs = append(fs, fstmp)
-		defer fs[len(fs)-1].Disconnect()
 		if err != nil {
-			log.Errorf("FreeSWITCH error: %s", err)
+			// The other nodes are still connected, this one being reported as not connected by the status
+			log.Errorf("FreeSWITCH error: %s:%s : %s", freeswitchConf.Host, freeswitchConf.Port, err)
 			fs[len(fs)-1] = nil
-			break
+			continue
 		}
 		log.Debugf("tlc_sessions freeswitch connected : host: %s  / port: %s / pass: %s / pole : %s / freeswitch retry number : %d", freeswitchConf.Host, freeswitchConf.Port, freeswitchConf.Pass, freeswitchConf.Pole, freeswitchConf.RetryNumber)
-		//Set sessions from redis into sessions memory if they exist in one freeswitch
-		if !errBool {
-			for _, session := range sessions {
-				result, err := fs[len(fs)-1].SendApiCmd("show calls as json")
-				if err != nil {
-					panic(err)
-				}
-				liveCalls := &Livecalls{}
-				err = json.Unmarshal([]byte(result), liveCalls)
-				if err != nil {
-					panic(err)
-				}
-				for _, liveCall := range liveCalls.Rows {
-					if (session.CallerUid == liveCall.Uuid && session.CalleeUid == liveCall.BUuid) || (session.CallerUid == liveCall.BUuid && session.CalleeUid == liveCall.Uuid) {
-						setSessions(append(*sessionsservice.GetSessions(), session))
-					}
-				}
-			}
-			log.Debugf("tlc_sessions session found in freeswitch after restart : %s", sessionsservice.GetSessions())
+	}
+
+	//Rebuild sessions from the channels alive on every freeswitch, restoring the ones found in redis
+	if errBool {
+		sessions = nil
+	}
+	report := reconcileSessions(sessions)
+	log.Debugf("tlc_sessions sessions reconciled after restart : %s", report)
+	for _, fsConn := range fs {
+		if fsConn != nil {
+			go fsConn.ReadEvents()
 		}
-		go fs[len(fs)-1].ReadEvents()
 	}
 
-	//Infinite loop to debug with log
+	go watchFreeswitchConnections(runCtx)
+	go watchHealth(runCtx, healthServer)
+	go reconcileSessionsPeriodically(runCtx, time.Duration(config.Sessions.ReconcileInterval)*time.Second)
+	go reapStaleSessionsPeriodically(runCtx, time.Duration(config.Sessions.Cycle)*time.Second, time.Duration(config.Sessions.ReapAfter)*time.Second, config.Sessions.ReapMode == "flag")
+
+	//Loop to debug with log until SIGINT or SIGTERM
 	for {
-		time.Sleep(time.Duration(config.Sessions.Cycle) * time.Second)
+		select {
+		case <-runCtx.Done():
+			shutdown(grpcServer, healthServer, time.Duration(config.Sessions.ShutdownTimeout)*time.Second)
+			return
+		case <-time.After(time.Duration(config.Sessions.Cycle) * time.Second):
+		}
+		purgeEndedSessions(time.Duration(config.Sessions.EndedGrace) * time.Second)
+		hits, misses, entries := numCache.Stats()
+		log.Debugf("tlc_sessions num cache : hits : %d / misses : %d / entries : %d", hits, misses, entries)
 	}
 }
 
@@ -158,7 +210,12 @@ func (s *server) GetSessionCopyService(ctx context.Context, in *sessionsservice.
 	var session *sessionsservice.Session
 	var found bool
 	log.Debugf("Received:GetSessionCopyService : %v / %v", in.GetCallerUid(), in.GetCalleeUid())
-	session, _, found = sessionsservice.GetSession(in.GetCallerUid(), in.GetCalleeUid(), in.GetExactly(), in.GetOnlyOneUid())
+	activeSessions.Lock()
+	defer activeSessions.Unlock()
+	session, _, found = activeSessions.Get(in.GetCallerUid(), in.GetCalleeUid(), in.GetExactly(), in.GetOnlyOneUid())
+	if !found {
+		session, found = getEndedSession(in.GetCallerUid(), in.GetCalleeUid(), in.GetExactly(), in.GetOnlyOneUid())
+	}
 	if found {
 		log.Debugf("Received:GetSessionCopyService : Found and send")
 		return sessionsservice.SessionToSessionsService(session), nil
@@ -168,64 +225,88 @@ func (s *server) GetSessionCopyService(ctx context.Context, in *sessionsservice.
 	}
 }
 
-// Set variable to session from GRPC
-func (s *server) SetVar(ctx context.Context, in *sessionsservice.Var) (*wrapperspb.BoolValue, error) {
-	SetVar(in.GetCallerUid(), in.GetCalleeUid(), in.GetNeededKey(), in.GetNeededValue())
-	return &wrapperspb.BoolValue{Value: true}, nil
-}
-
-// Set variable to session
-func SetVar(callerUuid string, calleeUid string, key string, value string) bool {
-	for _, oneFs := range fs {
-		oneFs.SendBgapiCmd("uuid_setvar " + callerUuid + " " + key + " " + value)
-		oneFs.SendBgapiCmd("uuid_setvar " + calleeUid + " " + key + " " + value)
+// Forget the cached database data of a number, or of all the numbers, from GRPC
+func (s *server) InvalidateNumber(ctx context.Context, in *sessionsservice.InvalidateNumberRequest) (*wrapperspb.BoolValue, error) {
+	log.Debugf("Received:InvalidateNumber : %s / all : %v", in.GetNum(), in.GetAll())
+	if numCache == nil {
+		return &wrapperspb.BoolValue{Value: false}, nil
+	}
+	if in.GetAll() {
+		return &wrapperspb.BoolValue{Value: numCache.InvalidateAll()}, nil
 	}
-	return true
+	return &wrapperspb.BoolValue{Value: numCache.Invalidate(in.GetNum())}, nil
 }
 
-// Set multiple variable from GRPC
-func (s *server) SetVarMultiple(ctx context.Context, in *sessionsservice.VarMultiple) (*wrapperspb.BoolValue, error) {
-	SetVarMultiple(in.GetCallerUid(), in.GetCalleeUid(), in.GetNeededKeyValue())
-	return &wrapperspb.BoolValue{Value: true}, nil
+// Used via GRCP to dump all session
+func (s *server) GetSessionsCopyService(ctx context.Context, empty *sessionsservice.Nil) (*sessionsservice.SessionsCopy, error) {
+	log.Debugf("Received:GetSessionsCopy")
+	activeSessions.Lock()
+	defer activeSessions.Unlock()
+	return sessionsservice.GetSessionsCopyService(activeSessions.List()), nil
 }
 
-// Set multiple variable to session
-func SetVarMultiple(callerUuid string, calleeUuid string, neededKeyValue map[string]string) bool {
-	var keyValueCommand string = ""
-	for neededKey, neededValue := range neededKeyValue {
-		if keyValueCommand == "" {
-			keyValueCommand = neededKey + "=" + neededValue
-		} else {
-			keyValueCommand = keyValueCommand + ";" + neededKey + "=" + neededValue
+// Used via GRCP to stream sessions changes, starting with a snapshot or with the changes after in.Revision of in.Epoch
+func (s *server) WatchSessions(in *sessionsservice.WatchSessionsRequest, stream sessionsservice.SessionsService_WatchSessionsServer) error {
+	log.Debugf("Received:WatchSessions : from revision %d / epoch %s", in.GetRevision(), in.GetEpoch())
+	activeSessions.Lock()
+	watcher, catchUp := subscribeSessionChanges(in.GetEpoch(), in.GetRevision())
+	activeSessions.Unlock()
+	defer unsubscribeSessionChanges(watcher)
+	for _, change := range catchUp {
+		if err := stream.Send(change); err != nil {
+			return err
 		}
 	}
-	for _, oneFs := range fs {
-		oneFs.SendBgapiCmd("uuid_setvar_multi " + callerUuid + " " + keyValueCommand)
-		oneFs.SendBgapiCmd("uuid_setvar_multi " + calleeUuid + " " + keyValueCommand)
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case change, open := <-watcher:
+			if !open && sessionWatchClosed() {
+				return status.Error(codes.Unavailable, "tlc_sessions is shutting down, resume from the last revision received")
+			}
+			if !open {
+				return status.Error(codes.ResourceExhausted, "watcher too slow, resume from the last revision received")
+			}
+			if err := stream.Send(change); err != nil {
+				return err
+			}
+		}
 	}
-	return true
 }
 
-// Used via GRCP to dump all session
-func (s *server) GetSessionsCopyService(ctx context.Context, empty *sessionsservice.Nil) (*sessionsservice.SessionsCopy, error) {
-	log.Debugf("Received:GetSessionsCopy")
-	return sessionsservice.GetSessionsCopyService(*sessionsservice.GetSessions()), nil
+// Add a new session and notify the watchers
+func addSession(session *sessionsservice.Session, reason string) {
+	session.CallId = activeCalls.trackSession(session)
+	activeSessions.Add(*session)
+	setRedisDatabaseSession(session)
+	publishSessionChange(sessionsservice.SessionChange_CREATED, reason, session)
 }
 
-func setSessions(sessions []sessionsservice.Session) {
-	sessionsservice.SetSessions(sessions)
-	setRedisDatabaseSessions(sessions)
+// Replace the session at sessionId and notify the watchers
+func updateSession(sessionId int, session *sessionsservice.Session, reason string) {
+	session.CallId = activeCalls.trackSession(session)
+	previous, found := activeSessions.Update(sessionId, *session)
+	if found && redisSessionKey(previous) != redisSessionKey(session) {
+		delRedisDatabaseSession(previous)
+	}
+	setRedisDatabaseSession(session)
+	publishSessionChange(sessionsservice.SessionChange_UPDATED, reason, session)
 }
 
-func removeSessions(uniqueId string, otherId string) {
-	sessionsservice.RemoveSession(uniqueId, otherId)
-	setRedisDatabaseSessions(*sessionsservice.GetSessions())
+func removeSessions(uniqueId string, otherId string, reason string) (*sessionsservice.Session, bool) {
+	session, found := activeSessions.Remove(uniqueId, otherId)
+	if found {
+		delRedisDatabaseSession(session)
+		publishSessionChange(sessionsservice.SessionChange_REMOVED, reason, session)
+	}
+	return session, found
 }
 
 // Called when a channel is created on freeswitch
 func channelCreate(eventStr string, connIdx int) {
-	sessionsservice.LockSessions()
-	defer sessionsservice.UnlockSessions()
+	activeSessions.Lock()
+	defer activeSessions.Unlock()
 	var event events.Event = events.CreateEvent(eventStr)
 	log.Debugf("BEFORE EVENT : %+v", event)
 	var session *sessionsservice.Session
@@ -240,7 +321,7 @@ func channelCreate(eventStr string, connIdx int) {
 			session, sessionId, foundSession = sessionsservice.GetSession(event.UniqueId, event.OtherId, false)
 		}
 	} else {*/
-	session, sessionId, foundSession = sessionsservice.GetSession(event.UniqueId, event.OtherId, false, false)
+	session, sessionId, foundSession = activeSessions.Get(event.UniqueId, event.OtherId, false, false)
 	//}
 	if !foundSession {
 		/*if event.IsC2C != "" && len(event.CallerNumber) > 4 && len(event.CalleeNumber) > 4 && event.OriginalCaller2 == event.CallerNumber && event.OriginalCallee == event.CalleeNumber {
@@ -259,10 +340,10 @@ func channelCreate(eventStr string, connIdx int) {
 		session.CallerUid = event.UniqueId
 		session.CalleeUid = event.OtherId
 		session.CallState = event.CallState
-		session.Pole = event.Pole
+		stampSessionNode(session, connIdx)
 		session.DateStart = event.CreateTime
 		session.OtherLegCalleeIdName = event.OtherLegCalleeIdName
-		setSessions(append(*sessionsservice.GetSessions(), *session))
+		addSession(session, event.EventName)
 		log.Debugf("AFTER : %+v", session)
 		//}
 	} else {
@@ -270,7 +351,7 @@ func channelCreate(eventStr string, connIdx int) {
 		log.Debugf("BEFORE SESSION : %+v", session)
 		setCustomsVariablesNeededFromEvent(event, session)
 		fixSessionUids(event, session)
-		session.Pole = event.Pole
+		stampSessionNode(session, connIdx)
 		/*if isRobot {
 			if event.UniqueId != "" {
 				session.CallerUid = event.UniqueId
@@ -279,20 +360,18 @@ func channelCreate(eventStr string, connIdx int) {
 				session.CalleeUid = event.AcdUuid
 			}
 		}*/
-		sessions := *sessionsservice.GetSessions()
-		sessions[sessionId] = *session
-		setSessions(sessions)
+		updateSession(sessionId, session, event.EventName)
 		log.Debugf("AFTER : %+v", session)
 	}
 }
 
 // Called when a channel is in ringing on freeswitch
 func channelProgress(eventStr string, connIdx int) {
-	sessionsservice.LockSessions()
-	defer sessionsservice.UnlockSessions()
+	activeSessions.Lock()
+	defer activeSessions.Unlock()
 	var event events.Event = events.CreateEvent(eventStr)
 	log.Debugf("BEFORE EVENT : %+v", event)
-	session, sessionId, foundSession := sessionsservice.GetSession(event.UniqueId, event.OtherId, false, false)
+	session, sessionId, foundSession := activeSessions.Get(event.UniqueId, event.OtherId, false, false)
 	if foundSession {
 		/*if event.IsC2C != "" && event.OtherType == "" {
 			logSession(event, session, "SESSION BLOCKED BECAUSE C2C CALLER PROGRESS")
@@ -324,14 +403,12 @@ func channelProgress(eventStr string, connIdx int) {
 		}
 		session.CallState = "RINGING"
 		session.OtherLegCalleeIdName = event.OtherLegCalleeIdName
-		session.Pole = event.Pole
-		sessions := *sessionsservice.GetSessions()
-		sessions[sessionId] = *session
-		setSessions(sessions)
+		stampSessionNode(session, connIdx)
+		updateSession(sessionId, session, event.EventName)
 		log.Debugf("AFTER : %+v", session)
 		//}
 	} else {
-		logSession(event, session, "SESSION NOT FOUND")
+		logSessionNotFound(event, session)
 	}
 }
 
@@ -368,15 +445,19 @@ func channelProgress(eventStr string, connIdx int) {
 
 // Called when a channel is bridged on freeswitch
 func channelBridge(eventStr string, connIdx int) {
-	sessionsservice.LockSessions()
-	defer sessionsservice.UnlockSessions()
+	activeSessions.Lock()
+	defer activeSessions.Unlock()
 	var event events.Event = events.CreateEvent(eventStr)
 	log.Debugf("BEFORE EVENT : %+v", event)
-	session, sessionId, foundSession := sessionsservice.GetSession(event.UniqueId, event.OtherId, false, false)
+	if completeTransfer(event, connIdx) {
+		return
+	}
+	session, sessionId, foundSession := activeSessions.Get(event.UniqueId, event.OtherId, false, false)
 	if foundSession {
 		logSession(event, session, "SESSION FOUND")
 		log.Debugf("BEFORE SESSION : %+v", session)
 		setCustomsVariablesNeededFromEvent(event, session)
+		session.TransferringUid = ""
 		/*if len(event.CallerNumber) == 4 {
 			session.CallerNum = event.CallerNumber
 		}
@@ -386,16 +467,14 @@ func channelBridge(eventStr string, connIdx int) {
 			session.CalleeNum = event.EffectiveCalleeIdNumber
 		}*/
 		session.CallState = event.CallState
-		session.Pole = event.Pole
+		stampSessionNode(session, connIdx)
 		session.OtherLegCalleeIdName = event.OtherLegCalleeIdName
 		fixSessionUids(event, session)
 		//if session.DateCon == "" {
 		session.DateCon = event.EventDate
 		//}
-		//updateSessionFromDatabase(session, event)
-		sessions := *sessionsservice.GetSessions()
-		sessions[sessionId] = *session
-		setSessions(sessions)
+		updateSessionFromDatabase(session)
+		updateSession(sessionId, session, event.EventName)
 		log.Debugf("AFTER : %+v", session)
 	} else {
 		logSession(event, session, "SESSION CREATE")
@@ -411,64 +490,71 @@ func channelBridge(eventStr string, connIdx int) {
 		session.CallerUid = event.UniqueId
 		session.CalleeUid = event.OtherId
 		session.CallState = event.CallState
-		session.Pole = event.Pole
+		stampSessionNode(session, connIdx)
 		session.DateStart = event.EventDate
 		session.DateRing = event.EventDate
 		session.DateCon = event.EventDate
-		//updateSessionFromDatabase(session, event)
-		setSessions(append(*sessionsservice.GetSessions(), *session))
+		updateSessionFromDatabase(session)
+		addSession(session, event.EventName)
 		log.Debugf("AFTER : %+v", session)
 	}
+	activeCalls.bridge(event.UniqueId, event.OtherId, event.EventDate)
 }
 
 // Called when a channel is unbridged on freeswitch
 func channelUnbridge(eventStr string, connIdx int) {
-	sessionsservice.LockSessions()
-	defer sessionsservice.UnlockSessions()
+	activeSessions.Lock()
+	defer activeSessions.Unlock()
 	var event events.Event = events.CreateEvent(eventStr)
 	log.Debugf("BEFORE EVENT : %+v", event)
-	session, _, foundSession := sessionsservice.GetSession(event.UniqueId, event.OtherId, false, false)
-	if foundSession {
+	activeCalls.unbridge(event.UniqueId, event.OtherId, event.EventDate)
+	session, _, foundSession := activeSessions.Get(event.UniqueId, event.OtherId, false, false)
+	if foundSession && session.TransferringUid != "" {
+		logSession(event, session, "SESSION TRANSFERRING")
+	} else if foundSession {
 		logSession(event, session, "SESSION FOUND")
-		removeSessions(event.UniqueId, event.OtherId)
+		endSession(event.UniqueId, event.OtherId, event)
 	} else {
-		logSession(event, session, "SESSION NOT FOUND")
+		logSessionNotFound(event, session)
 	}
 }
 
 // Called when a channel is destroyed on freeswitch
 func channelDestroy(eventStr string, connIdx int) {
-	sessionsservice.LockSessions()
-	defer sessionsservice.UnlockSessions()
+	activeSessions.Lock()
+	defer activeSessions.Unlock()
 	var event events.Event = events.CreateEvent(eventStr)
 	log.Debugf("BEFORE EVENT : %+v", event)
+	activeCalls.endLeg(event.UniqueId, event.HangupTime, event.HangupCause)
 	var session *sessionsservice.Session
 	var foundSession bool
 	/*if event.IsRobot == "1" {
 		session, _, foundSession = sessionsservice.GetSession(event.UniqueId, event.OtherId, false)
 	} else {*/
-	session, _, foundSession = sessionsservice.GetSession(event.UniqueId, event.OtherId, true, false)
+	session, _, foundSession = activeSessions.Get(event.UniqueId, event.OtherId, true, false)
 	//}
-	if foundSession {
+	if foundSession && session.TransferringUid != "" && session.TransferringUid != event.UniqueId {
+		logSession(event, session, "SESSION TRANSFERRING")
+	} else if foundSession {
 		logSession(event, session, "SESSION FOUND")
-		removeSessions(event.UniqueId, event.OtherId)
+		endSession(event.UniqueId, event.OtherId, event)
 	} else {
-		logSession(event, session, "SESSION NOT FOUND")
-		session, _, foundSession = sessionsservice.GetSession(event.UniqueId, event.OtherId, false, false)
+		logSessionNotFound(event, session)
+		session, _, foundSession = activeSessions.Get(event.UniqueId, event.OtherId, false, false)
 		if foundSession {
 			logSession(event, session, "SESSION FOUND (NOT EXACTLY)")
-			removeSessions(event.UniqueId, event.OtherId)
+			endSession(event.UniqueId, event.OtherId, event)
 		}
 	}
 }
 
 // Called when a channel is parked (virtual agents) on freeswitch
 func channelPark(eventStr string, connIdx int) {
-	sessionsservice.LockSessions()
-	defer sessionsservice.UnlockSessions()
+	activeSessions.Lock()
+	defer activeSessions.Unlock()
 	var event events.Event = events.CreateEvent(eventStr)
 	log.Debugf("BEFORE EVENT : %+v", event)
-	session, _, foundSession := sessionsservice.GetSession(event.UniqueId, event.OtherId, false, false)
+	session, _, foundSession := activeSessions.Get(event.UniqueId, event.OtherId, false, false)
 	if foundSession {
 		logSession(event, session, "SESSION FOUND")
 	} else {
@@ -486,33 +572,34 @@ func channelPark(eventStr string, connIdx int) {
 		session.DateRing = event.EventDate
 		session.DateCon = event.EventDate
 		session.OtherLegCalleeIdName = event.OtherLegCalleeIdName
-		setSessions(append(*sessionsservice.GetSessions(), *session))
+		stampSessionNode(session, connIdx)
+		addSession(session, event.EventName)
 		log.Debugf("AFTER : %+v", session)
 	}
 }
 
 // Called when a channel is unpark (virtual agents) on freeswitch
 func channelUnpark(eventStr string, connIdx int) {
-	sessionsservice.LockSessions()
-	defer sessionsservice.UnlockSessions()
+	activeSessions.Lock()
+	defer activeSessions.Unlock()
 	var event events.Event = events.CreateEvent(eventStr)
 	log.Debugf("BEFORE EVENT : %+v", event)
-	session, _, foundSession := sessionsservice.GetSession(event.UniqueId, event.OtherId, true, false)
+	session, _, foundSession := activeSessions.Get(event.UniqueId, event.OtherId, true, false)
 	if foundSession {
 		logSession(event, session, "SESSION FOUND")
-		removeSessions(event.UniqueId, event.OtherId)
+		endSession(event.UniqueId, event.OtherId, event)
 	} else {
-		logSession(event, session, "SESSION NOT FOUND")
+		logSessionNotFound(event, session)
 	}
 }
 
 // Called when a sound is played by IVR on the call
 func playbackStart(eventStr string, connIdx int) {
-	sessionsservice.LockSessions()
-	defer sessionsservice.UnlockSessions()
+	activeSessions.Lock()
+	defer activeSessions.Unlock()
 	var event events.Event = events.CreateEvent(eventStr)
 	log.Debugf("BEFORE EVENT : %+v", event)
-	session, sessionId, foundSession := sessionsservice.GetSession(event.UniqueId, event.OtherId, true, false)
+	session, sessionId, foundSession := activeSessions.Get(event.UniqueId, event.OtherId, true, false)
 	if foundSession {
 		logSession(event, session, "SESSION FOUND")
 		log.Debugf("BEFORE SESSION : %+v", session)
@@ -523,10 +610,8 @@ func playbackStart(eventStr string, connIdx int) {
 		}
 		//session.CallerNum = event.CallerNumber
 		//session.CalleeNum = event.EffectiveCalleeIdNumber
-		session.Pole = event.Pole
-		sessions := *sessionsservice.GetSessions()
-		sessions[sessionId] = *session
-		setSessions(sessions)
+		stampSessionNode(session, connIdx)
+		updateSession(sessionId, session, event.EventName)
 		log.Debugf("AFTER : %+v", session)
 	} else {
 		logSession(event, session, "SESSION CREATE")
@@ -535,24 +620,24 @@ func playbackStart(eventStr string, connIdx int) {
 		setCustomsVariablesNeededFromEvent(event, session)
 		session.CallerNum = event.CallerNumber
 		session.CalleeNum = event.EffectiveCalleeIdNumber
-		session.Pole = event.Pole
+		stampSessionNode(session, connIdx)
 		session.CallerUid = event.UniqueId
 		session.CalleeUid = event.OtherId
 		session.DateStart = event.CreateTime
 		session.DateRing = event.CreateTime
 		session.DateCon = event.CreateTime
-		setSessions(append(*sessionsservice.GetSessions(), *session))
+		addSession(session, event.EventName)
 		log.Debugf("AFTER : %+v", session)
 	}
 }
 
 // Called when a call record start on freeswitch
 func recordStart(eventStr string, connIdx int) {
-	sessionsservice.LockSessions()
-	defer sessionsservice.UnlockSessions()
+	activeSessions.Lock()
+	defer activeSessions.Unlock()
 	var event events.Event = events.CreateEvent(eventStr)
 	log.Debugf("BEFORE EVENT : %+v", event)
-	session, sessionId, foundSession := sessionsservice.GetSession(event.UniqueId, event.OtherId, false, false)
+	session, sessionId, foundSession := activeSessions.Get(event.UniqueId, event.OtherId, false, false)
 	if foundSession {
 		logSession(event, session, "SESSION FOUND")
 		log.Debugf("BEFORE SESSION : %+v", session)
@@ -560,60 +645,44 @@ func recordStart(eventStr string, connIdx int) {
 		session.RecordingName = event.RecordId
 		session.IsRecorded = "1"
 		fixSessionUids(event, session)
-		sessions := *sessionsservice.GetSessions()
-		sessions[sessionId] = *session
-		setSessions(sessions)
+		updateSession(sessionId, session, event.EventName)
 		log.Debugf("AFTER : %+v", session)
-		session_clone, _, foundSessionClone := sessionsservice.GetSession(event.OtherId, "", false, false)
+		session_clone, _, foundSessionClone := activeSessions.Get(event.OtherId, "", false, false)
 		if foundSessionClone {
 			if (session_clone.CallerUid + session_clone.CalleeUid) != (session.CallerUid + session.CalleeUid) {
 				logSession(event, session_clone, "SESSION CLONE FOUND AND DESTROYED")
-				removeSessions(session_clone.CallerUid, session_clone.CalleeUid)
+				removeSessions(session_clone.CallerUid, session_clone.CalleeUid, event.EventName)
+				for _, uid := range []string{session_clone.CallerUid, session_clone.CalleeUid} {
+					if uid != session.CallerUid && uid != session.CalleeUid {
+						activeCalls.endLeg(uid, time.Now(), event.EventName)
+					}
+				}
 			}
 		}
 	} else {
-		logSession(event, session, "SESSION NOT FOUND")
+		logSessionNotFound(event, session)
 	}
 }
 
 // Called when a API command is executed on freeswitch
 func apiCommand(eventStr string, connIdx int) {
-	sessionsservice.LockSessions()
-	defer sessionsservice.UnlockSessions()
+	activeSessions.Lock()
+	defer activeSessions.Unlock()
 	var event events.Event = events.CreateEvent(eventStr)
 	if event.ApiCommand == "uuid_setvar" || event.ApiCommand == "uuid_setvar_multi" {
 		log.Debugf("BEFORE EVENT : %+v", event)
 		log.Debugf("BEFORE EVENTSTR : %s", strings.Replace(eventStr, "\n", " / ", -1))
 		//log.Debugf("DEBUG LO : %+v", event)
 		//log.Debugf("before : %+v", sessionsservice.GetSessions())
-		var uuid = strings.Split(event.ApiCommandArgument, " ")[0]
-		commandArgsWithUuid := strings.Split(event.ApiCommandArgument, ";")
-		commandArgsWithUuid[0] = strings.ReplaceAll(commandArgsWithUuid[0], uuid+" ", "")
-		var commandArgsWithoutUuid = make(map[string]string)
-		for _, arg := range commandArgsWithUuid {
-			argString := string(arg)
-			var commandSplitted []string
-			if strings.Contains(argString, "=") {
-				commandSplitted = strings.Split(argString, "=")
-			} else {
-				commandSplitted = strings.Split(argString, " ")
-			}
-			if len(commandSplitted) > 1 {
-				commandArgsWithoutUuid[commandSplitted[0]] = commandSplitted[1]
-			} else {
-				commandArgsWithoutUuid[commandSplitted[0]] = ""
-			}
-		}
-		session, sessionId, foundSession := sessionsservice.GetSession(uuid, "", false, true)
+		uuid, commandArgsWithoutUuid := parseSetVarArgs(event.ApiCommand, event.ApiCommandArgument)
+		session, sessionId, foundSession := activeSessions.Get(uuid, "", false, true)
 		if foundSession {
 			logSession(event, session, "SESSION FOUND")
 			log.Debugf("BEFORE SESSION : %+v", session)
 			//log.Debugf("before : %+v", session)
 			session = mergeEventMapIntoSession(commandArgsWithoutUuid, session)
 			//log.Debugf("after : %+v", sessionsservice.GetSessions())
-			sessions := *sessionsservice.GetSessions()
-			sessions[sessionId] = *session
-			setSessions(sessions)
+			updateSession(sessionId, session, event.EventName)
 			log.Debugf("AFTER : %+v", session)
 			//log.Debugf("after : %+v", sessionsservice.GetSessions())
 		}
@@ -631,37 +700,35 @@ func apiCommand(eventStr string, connIdx int) {
 }*/
 
 func channelHold(eventStr string, connIdx int) {
-	sessionsservice.LockSessions()
-	defer sessionsservice.UnlockSessions()
+	activeSessions.Lock()
+	defer activeSessions.Unlock()
 	var event events.Event = events.CreateEvent(eventStr)
 	log.Debugf("BEFORE EVENT : %+v", event)
-	session, sessionId, foundSession := sessionsservice.GetSession(event.UniqueId, event.OtherId, false, false)
+	session, sessionId, foundSession := activeSessions.Get(event.UniqueId, event.OtherId, false, false)
 	if foundSession {
 		logSession(event, session, "SESSION FOUND")
 		log.Debugf("BEFORE SESSION : %+v", session)
 		setCustomsVariablesNeededFromEvent(event, session)
 		session.CallState = event.CallState
-		sessions := *sessionsservice.GetSessions()
-		sessions[sessionId] = *session
-		setSessions(sessions)
+		startHold(session, event)
+		updateSession(sessionId, session, event.EventName)
 		log.Debugf("AFTER : %+v", session)
 	}
 }
 
 func channelUnhold(eventStr string, connIdx int) {
-	sessionsservice.LockSessions()
-	defer sessionsservice.UnlockSessions()
+	activeSessions.Lock()
+	defer activeSessions.Unlock()
 	var event events.Event = events.CreateEvent(eventStr)
 	log.Debugf("BEFORE EVENT : %+v", event)
-	session, sessionId, foundSession := sessionsservice.GetSession(event.UniqueId, event.OtherId, false, false)
+	session, sessionId, foundSession := activeSessions.Get(event.UniqueId, event.OtherId, false, false)
 	if foundSession {
 		logSession(event, session, "SESSION FOUND")
 		log.Debugf("BEFORE SESSION : %+v", session)
 		setCustomsVariablesNeededFromEvent(event, session)
 		session.CallState = "ACTIVE"
-		sessions := *sessionsservice.GetSessions()
-		sessions[sessionId] = *session
-		setSessions(sessions)
+		endHoldFromEvent(session, event)
+		updateSession(sessionId, session, event.EventName)
 		log.Debugf("AFTER : %+v", session)
 	}
 }
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	eventsReceived = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "tlc_sessions_events_received_total",
+		Help: "FreeSWITCH events received, by Event-Name and connection.",
+	}, []string{"event_name", "conn_idx"})
+	handlerDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "tlc_sessions_handler_duration_seconds",
+		Help:    "Time spent handling a FreeSWITCH event, by Event-Name.",
+		Buckets: prometheus.ExponentialBuckets(0.0001, 4, 8),
+	}, []string{"event_name"})
+	sessionsNotFound = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "tlc_sessions_session_not_found_total",
+		Help: "Events for which no session was found, by handler.",
+	}, []string{"handler"})
+	sessionsReaped = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "tlc_sessions_sessions_reaped_total",
+		Help: "Sessions whose channels no longer exist on their FreeSWITCH, removed or flagged by the reaper.",
+	})
+	redisWriteDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "tlc_sessions_redis_write_duration_seconds",
+		Help:    "Time spent writing the queued sessions into redis, by operation.",
+		Buckets: prometheus.ExponentialBuckets(0.0001, 4, 8),
+	}, []string{"operation"})
+	redisWriteFailures = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "tlc_sessions_redis_write_failures_total",
+		Help: "Failed writes of the queued sessions into redis.",
+	})
+	redisDegraded = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "tlc_sessions_redis_degraded",
+		Help: "1 while redis is down and the sessions are only kept in memory.",
+	})
+	redisQueueLength = prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Name: "tlc_sessions_redis_queue_length",
+		Help: "Sessions waiting to be written into redis.",
+	}, func() float64 { return float64(redisQueue.len()) })
+	grpcRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "tlc_sessions_grpc_requests_total",
+		Help: "gRPC requests handled, by method and status code.",
+	}, []string{"method", "code"})
+	activeSessionsDesc = prometheus.NewDesc(
+		"tlc_sessions_active_sessions",
+		"Sessions in memory, by pole and call state.",
+		[]string{"pole", "call_state"}, nil)
+)
+
+// Count the sessions in memory when scraped
+type activeSessionsCollector struct{}
+
+func (collector activeSessionsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- activeSessionsDesc
+}
+
+func (collector activeSessionsCollector) Collect(ch chan<- prometheus.Metric) {
+	type key struct {
+		pole      string
+		callState string
+	}
+	counts := make(map[key]int)
+	activeSessions.Lock()
+	for _, session := range activeSessions.List() {
+		counts[key{session.Pole, session.CallState}]++
+	}
+	activeSessions.Unlock()
+	for k, count := range counts {
+		ch <- prometheus.MustNewConstMetric(activeSessionsDesc, prometheus.GaugeValue, float64(count), k.pole, k.callState)
+	}
+}
+
+func init() {
+	prometheus.MustRegister(eventsReceived, handlerDuration, sessionsNotFound, sessionsReaped, redisWriteDuration, redisWriteFailures, redisDegraded, redisQueueLength, grpcRequests, activeSessionsCollector{})
+}
+
+// Serve the metrics over http, nothing is served if no port is configured
+func startMetricsListener(port int) {
+	if port == 0 {
+		return
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	go func() {
+		err := http.ListenAndServe(fmt.Sprintf(":%d", port), mux)
+		if err != nil {
+			log.Errorf("Metrics error: %s", err)
+		}
+	}()
+}
+
+// Wrap the handlers to count the events and measure their handling
+func instrumentHandlers(evHandlers map[string][]func(string, int)) map[string][]func(string, int) {
+	instrumented := make(map[string][]func(string, int))
+	for eventName, handlers := range evHandlers {
+		for _, handler := range handlers {
+			eventName, handler := eventName, handler
+			instrumented[eventName] = append(instrumented[eventName], func(eventStr string, connIdx int) {
+				eventsReceived.WithLabelValues(eventName, strconv.Itoa(connIdx)).Inc()
+				start := time.Now()
+				handler(eventStr, connIdx)
+				handlerDuration.WithLabelValues(eventName).Observe(time.Since(start).Seconds())
+			})
+		}
+	}
+	return instrumented
+}
+
+func observeRedisWrite(operation string, start time.Time) {
+	redisWriteDuration.WithLabelValues(operation).Observe(time.Since(start).Seconds())
+}
+
+func countGrpcRequest(method string, err error) {
+	grpcRequests.WithLabelValues(method, status.Code(err).String()).Inc()
+}
+
+func grpcUnaryMetrics(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	countGrpcRequest(info.FullMethod, err)
+	return resp, err
+}
+
+func grpcStreamMetrics(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	err := handler(srv, ss)
+	countGrpcRequest(info.FullMethod, err)
+	return err
+}
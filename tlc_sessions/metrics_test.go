@@ -0,0 +1,39 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/fetristan/tlc_sessions/sessionsservice"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestInstrumentHandlers(t *testing.T) {
+	handlers := instrumentHandlers(map[string][]func(string, int){
+		"CHANNEL_HOLD": {func(eventStr string, connIdx int) {}},
+	})
+	before := testutil.ToFloat64(eventsReceived.WithLabelValues("CHANNEL_HOLD", "2"))
+	handlers["CHANNEL_HOLD"][0]("", 2)
+	handlers["CHANNEL_HOLD"][0]("", 2)
+	if got := testutil.ToFloat64(eventsReceived.WithLabelValues("CHANNEL_HOLD", "2")) - before; got != 2 {
+		t.Errorf("events received = %v, want 2", got)
+	}
+}
+
+func TestActiveSessionsCollector(t *testing.T) {
+	activeSessions.Lock()
+	previous := activeSessions.List()
+	activeSessions.Set([]sessionsservice.Session{
+		{CallerUid: "a", Pole: "FR", CallState: "ACTIVE"},
+		{CallerUid: "b", Pole: "FR", CallState: "ACTIVE"},
+		{CallerUid: "c", Pole: "ES", CallState: "RINGING"},
+	})
+	activeSessions.Unlock()
+	defer func() {
+		activeSessions.Lock()
+		activeSessions.Set(previous)
+		activeSessions.Unlock()
+	}()
+	if count := testutil.CollectAndCount(activeSessionsCollector{}); count != 2 {
+		t.Errorf("active sessions series = %d, want 2", count)
+	}
+}
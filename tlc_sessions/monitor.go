@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fetristan/tlc_sessions/sessionsservice"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Call state of the supervisor legs until FreeSWITCH sends their events
+const monitorCallState = "MONITOR"
+
+// Random uuid given to the supervisor leg, known before FreeSWITCH creates it
+func newUuid() string {
+	var b [16]byte
+	rand.Read(b[:])
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+// Channel variables of the eavesdrop: whisper only to the monitored leg, barge to both legs
+func monitorVariables(mode sessionsservice.MonitorMode) []string {
+	switch mode {
+	case sessionsservice.MonitorMode_WHISPER:
+		return []string{"eavesdrop_whisper_aleg=true"}
+	case sessionsservice.MonitorMode_BARGE:
+		return []string{"eavesdrop_bridge_aleg=true", "eavesdrop_bridge_bleg=true"}
+	}
+	return nil
+}
+
+// Originate the supervisor leg to the extension, then eavesdrop on the monitored uid
+func monitorCommand(supervisorUid string, monitoredUid string, extension string, mode sessionsservice.MonitorMode) string {
+	variables := append([]string{
+		"origination_uuid=" + supervisorUid,
+		"tlc_monitored_uid=" + monitoredUid,
+		"tlc_monitor_mode=" + mode.String(),
+	}, monitorVariables(mode)...)
+	return "originate {" + strings.Join(variables, ",") + "}user/" + extension + " &eavesdrop(" + monitoredUid + ")"
+}
+
+// Uid of the leg to monitor, from the session having the uid
+func monitoredUid(uid string, leg sessionsservice.MonitorLeg) (string, error) {
+	activeSessions.Lock()
+	defer activeSessions.Unlock()
+	session, _, found := activeSessions.Get(uid, "", false, true)
+	if !found {
+		return "", status.Errorf(codes.NotFound, "no session for uid %s", uid)
+	}
+	monitored := session.CallerUid
+	if leg == sessionsservice.MonitorLeg_CALLEE {
+		monitored = session.CalleeUid
+	}
+	if monitored == "" {
+		return "", status.Errorf(codes.FailedPrecondition, "the session of uid %s has no %s leg", uid, strings.ToLower(leg.String()))
+	}
+	return monitored, nil
+}
+
+// Listen, whisper or barge into a session from GRPC, the supervisor leg being tracked as a session linked to it
+func (s *server) Monitor(ctx context.Context, in *sessionsservice.MonitorRequest) (*sessionsservice.MonitorReply, error) {
+	log.Debugf("Received:Monitor : %s / %s / %s / %s", in.GetUid(), in.GetLeg(), in.GetExtension(), in.GetMode())
+	if err := checkCommandArg("uid", in.GetUid(), true); err != nil {
+		return nil, err
+	}
+	if err := checkCommandArg("extension", in.GetExtension(), true); err != nil {
+		return nil, err
+	}
+	if strings.ContainsAny(in.GetExtension(), "{},/") {
+		return nil, status.Error(codes.InvalidArgument, "extension must not contain channel variables or a dial string")
+	}
+	monitored, err := monitoredUid(in.GetUid(), in.GetLeg())
+	if err != nil {
+		return nil, err
+	}
+	connIdx, err := sessionOwner(monitored)
+	if err != nil {
+		return nil, err
+	}
+	if connIdx >= len(fs) || fs[connIdx] == nil || !fs[connIdx].Connected() {
+		return nil, status.Errorf(codes.Unavailable, "FreeSWITCH %d is not connected", connIdx)
+	}
+
+	supervisor := sessionsservice.Session{
+		CallerUid:    newUuid(),
+		CallerNum:    in.GetExtension(),
+		CallState:    monitorCallState,
+		DateStart:    time.Now(),
+		MonitoredUid: monitored,
+		MonitorMode:  in.GetMode(),
+	}
+	stampSessionNode(&supervisor, connIdx)
+	activeSessions.Lock()
+	addSession(&supervisor, "MONITOR")
+	activeSessions.Unlock()
+
+	command := monitorCommand(supervisor.CallerUid, monitored, in.GetExtension(), in.GetMode())
+	log.Debugf("Monitor : FreeSWITCH %d : %s", connIdx, command)
+	reply, err := fs[connIdx].SendBgapiCmd(command)
+	if err != nil {
+		activeSessions.Lock()
+		if removed, found := removeSessions(supervisor.CallerUid, "", "MONITOR_FAILED"); found {
+			endSessionLegs(removed, "MONITOR_FAILED")
+		}
+		activeSessions.Unlock()
+		return nil, apiReplyError("", err)
+	}
+	go watchOriginateJob(supervisor.CallerUid, command, reply, "MONITOR_FAILED")
+	return &sessionsservice.MonitorReply{SupervisorUid: supervisor.CallerUid, MonitoredUid: monitored, Node: supervisor.Node}, nil
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fetristan/tlc_sessions/sessionsservice"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMonitorCommand(t *testing.T) {
+	for _, test := range []struct {
+		mode sessionsservice.MonitorMode
+		want string
+	}{
+		{sessionsservice.MonitorMode_LISTEN, "originate {origination_uuid=sup,tlc_monitored_uid=agent,tlc_monitor_mode=LISTEN}user/1001 &eavesdrop(agent)"},
+		{sessionsservice.MonitorMode_WHISPER, "originate {origination_uuid=sup,tlc_monitored_uid=agent,tlc_monitor_mode=WHISPER,eavesdrop_whisper_aleg=true}user/1001 &eavesdrop(agent)"},
+		{sessionsservice.MonitorMode_BARGE, "originate {origination_uuid=sup,tlc_monitored_uid=agent,tlc_monitor_mode=BARGE,eavesdrop_bridge_aleg=true,eavesdrop_bridge_bleg=true}user/1001 &eavesdrop(agent)"},
+	} {
+		if got := monitorCommand("sup", "agent", "1001", test.mode); got != test.want {
+			t.Errorf("%s : got %q, want %q", test.mode, got, test.want)
+		}
+	}
+}
+
+func TestMonitoredUid(t *testing.T) {
+	setTestSessions(t, []sessionsservice.Session{{CallerUid: "customer", CalleeUid: "agent"}, {CallerUid: "ivr"}})
+
+	if uid, err := monitoredUid("customer", sessionsservice.MonitorLeg_CALLEE); err != nil || uid != "agent" {
+		t.Errorf("callee leg = %q, %v", uid, err)
+	}
+	if uid, err := monitoredUid("agent", sessionsservice.MonitorLeg_CALLER); err != nil || uid != "customer" {
+		t.Errorf("caller leg = %q, %v", uid, err)
+	}
+	if _, err := monitoredUid("ivr", sessionsservice.MonitorLeg_CALLEE); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("missing leg : %v", err)
+	}
+	if _, err := (&server{}).Monitor(context.Background(), &sessionsservice.MonitorRequest{Uid: "customer", Extension: "user/1001"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("dial string extension : %v", err)
+	}
+}
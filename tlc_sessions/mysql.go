@@ -1 +1,129 @@
-Code removed for security
\ No newline at end of file
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// Used when database timeout is not configured
+const dbDefaultTimeout = 2 * time.Second
+
+type ExtensionDataFromDb struct {
+	Num               sql.NullString
+	IsAgent           sql.NullString
+	IsLogged          sql.NullString
+	UserId            sql.NullString
+	BeforeCallIvrArgs sql.NullString
+}
+
+type DidDataFromDb struct {
+	Sda sql.NullString
+}
+
+// Lookups of the numbers data, an empty result (not Valid) meaning the number is unknown
+type NumRepository interface {
+	GetExtensionParamsByExtension(ctx context.Context, extension string) (ExtensionDataFromDb, error)
+	GetDidParamsByDid(ctx context.Context, did string) (DidDataFromDb, error)
+}
+
+type Db struct {
+	conn                 *sql.DB
+	timeout              time.Duration
+	extensionByExtension *sql.Stmt
+	didByDid             *sql.Stmt
+}
+
+var numRepository NumRepository
+
+var errDbNotReady = errors.New("database not ready")
+
+func newDb(config *Config) (*Db, error) {
+	conn, err := sql.Open("mysql", config.Database.User+":"+config.Database.Pass+"@tcp("+config.Database.Host+":"+config.Database.Port+")/"+config.Database.Dbname+"?parseTime=true")
+	if err != nil {
+		return nil, err
+	}
+	conn.SetMaxOpenConns(config.Database.MaxOpenConns)
+	conn.SetMaxIdleConns(config.Database.MaxIdleConns)
+	conn.SetConnMaxLifetime(time.Duration(config.Database.ConnMaxLifetime) * time.Second)
+	db := &Db{conn: conn, timeout: time.Duration(config.Database.Timeout) * time.Second}
+	if db.timeout <= 0 {
+		db.timeout = dbDefaultTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), db.timeout)
+	defer cancel()
+	if err = conn.PingContext(ctx); err != nil {
+		return db, err
+	}
+	db.extensionByExtension, err = conn.PrepareContext(ctx, "SELECT num, is_agent, is_logged, user_id, before_call_ivr_args FROM extensions WHERE num = ? LIMIT 1")
+	if err != nil {
+		return db, err
+	}
+	db.didByDid, err = conn.PrepareContext(ctx, "SELECT sda FROM dids WHERE sda = ? LIMIT 1")
+	if err != nil {
+		return db, err
+	}
+	return db, nil
+}
+
+func (db *Db) Close() error {
+	if db.extensionByExtension != nil {
+		db.extensionByExtension.Close()
+	}
+	if db.didByDid != nil {
+		db.didByDid.Close()
+	}
+	return db.conn.Close()
+}
+
+func (db *Db) GetExtensionParamsByExtension(ctx context.Context, extension string) (ExtensionDataFromDb, error) {
+	var extensionData ExtensionDataFromDb
+	if db == nil || db.extensionByExtension == nil {
+		return extensionData, errDbNotReady
+	}
+	err := db.extensionByExtension.QueryRowContext(ctx, extension).Scan(&extensionData.Num, &extensionData.IsAgent, &extensionData.IsLogged, &extensionData.UserId, &extensionData.BeforeCallIvrArgs)
+	if err == sql.ErrNoRows {
+		return ExtensionDataFromDb{}, nil
+	}
+	return extensionData, err
+}
+
+func (db *Db) GetDidParamsByDid(ctx context.Context, did string) (DidDataFromDb, error) {
+	var didData DidDataFromDb
+	if db == nil || db.didByDid == nil {
+		return didData, errDbNotReady
+	}
+	err := db.didByDid.QueryRowContext(ctx, did).Scan(&didData.Sda)
+	if err == sql.ErrNoRows {
+		return DidDataFromDb{}, nil
+	}
+	return didData, err
+}
+
+func queryTimeout() time.Duration {
+	if db != nil {
+		return db.timeout
+	}
+	return dbDefaultTimeout
+}
+
+func getExtensionParamsByExtension(repository NumRepository, extension string) ExtensionDataFromDb {
+	ctx, cancel := context.WithTimeout(context.Background(), queryTimeout())
+	defer cancel()
+	extensionData, err := repository.GetExtensionParamsByExtension(ctx, extension)
+	if err != nil {
+		log.Errorf("Database : EXTENSION %s : %s", extension, err)
+	}
+	return extensionData
+}
+
+func getDidParamsByDid(repository NumRepository, did string) DidDataFromDb {
+	ctx, cancel := context.WithTimeout(context.Background(), queryTimeout())
+	defer cancel()
+	didData, err := repository.GetDidParamsByDid(ctx, did)
+	if err != nil {
+		log.Errorf("Database : DID %s : %s", did, err)
+	}
+	return didData
+}
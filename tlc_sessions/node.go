@@ -0,0 +1,65 @@
+package main
+
+import (
+	"github.com/cgrates/fsock"
+	"github.com/fetristan/tlc_sessions/sessionsservice"
+)
+
+// Stamp the session with the pole and node of the connection that delivered its events
+func stampSessionNode(session *sessionsservice.Session, connIdx int) {
+	if connIdx >= 0 && connIdx < len(fsStatus) {
+		session.Pole = fsStatus[connIdx].pole
+		session.Node = fsStatus[connIdx].host
+	}
+}
+
+// Connection of the node, the node being the host:port of a configured FreeSWITCH
+func nodeConnIdx(node string) (int, bool) {
+	for connIdx, status := range fsStatus {
+		if node != "" && status.host == node {
+			return connIdx, true
+		}
+	}
+	return 0, false
+}
+
+// Connections of the pole, or every connection if the pole is unknown
+func poleConnections(pole string) []int {
+	var connIdxs []int
+	for connIdx, status := range fsStatus {
+		if pole == "" || status.pole == pole {
+			connIdxs = append(connIdxs, connIdx)
+		}
+	}
+	if len(connIdxs) == 0 {
+		for connIdx := range fsStatus {
+			connIdxs = append(connIdxs, connIdx)
+		}
+	}
+	return connIdxs
+}
+
+// Connections that may hold the channels of the session: its node if known, else the ones of its pole
+func sessionConnections(session *sessionsservice.Session) []int {
+	if connIdx, found := nodeConnIdx(session.Node); found {
+		return []int{connIdx}
+	}
+	return poleConnections(session.Pole)
+}
+
+// Connected FreeSWITCH of the node, or every connected FreeSWITCH if the node is unknown
+func nodeFreeswitch(node string) []*fsock.FSock {
+	var nodeFs []*fsock.FSock
+	if connIdx, found := nodeConnIdx(node); found {
+		if connIdx < len(fs) && fs[connIdx] != nil {
+			nodeFs = append(nodeFs, fs[connIdx])
+		}
+		return nodeFs
+	}
+	for _, oneFs := range fs {
+		if oneFs != nil {
+			nodeFs = append(nodeFs, oneFs)
+		}
+	}
+	return nodeFs
+}
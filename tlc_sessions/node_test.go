@@ -0,0 +1,43 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/fetristan/tlc_sessions/sessionsservice"
+)
+
+func TestSessionConnections(t *testing.T) {
+	fsStatus = []*freeswitchStatus{{host: "fs1:8021", pole: "FR"}, {host: "fs2:8021", pole: "FR"}, {host: "fs3:8021", pole: "BE"}}
+	defer func() { fsStatus = nil }()
+
+	var session sessionsservice.Session
+	stampSessionNode(&session, 1)
+	if session.Pole != "FR" || session.Node != "fs2:8021" {
+		t.Fatalf("unexpected stamp %q %q", session.Pole, session.Node)
+	}
+	if connIdxs := sessionConnections(&session); !reflect.DeepEqual(connIdxs, []int{1}) {
+		t.Fatalf("node : %v", connIdxs)
+	}
+	session.Node = "gone:8021"
+	if connIdxs := sessionConnections(&session); !reflect.DeepEqual(connIdxs, []int{0, 1}) {
+		t.Fatalf("pole : %v", connIdxs)
+	}
+	session.Pole = ""
+	if connIdxs := sessionConnections(&session); !reflect.DeepEqual(connIdxs, []int{0, 1, 2}) {
+		t.Fatalf("unknown : %v", connIdxs)
+	}
+}
+
+func TestChannelParkStampsNode(t *testing.T) {
+	fsStatus = []*freeswitchStatus{{host: "fs1:8021", pole: "FR"}, {host: "fs2:8021", pole: "BE"}}
+	defer func() { fsStatus = nil }()
+	setTestSessions(t, nil)
+	channelPark("Event-Name: CHANNEL_PARK\nUnique-ID: p1\nCaller-Destination-Number: 3000\n", 1)
+	activeSessions.Lock()
+	session, _, found := activeSessions.Get("p1", "", false, false)
+	activeSessions.Unlock()
+	if !found || session.Pole != "BE" || session.Node != "fs2:8021" {
+		t.Errorf("parked session = %+v, %v", session, found)
+	}
+}
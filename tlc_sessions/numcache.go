@@ -0,0 +1,139 @@
+package main
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// NumRepository keeping the lookups of another one in memory, with a ttl and a maximum number of entries
+// (the least recently used being evicted first). Unknown numbers (external numbers) are cached too, with their own ttl.
+type cachedNumRepository struct {
+	repository  NumRepository
+	ttl         time.Duration
+	negativeTtl time.Duration
+	size        int
+	mutex       sync.Mutex
+	entries     map[string]*list.Element
+	lru         *list.List
+	hits        uint64
+	misses      uint64
+}
+
+type numCacheEntry struct {
+	key           string
+	expire        time.Time
+	extensionData ExtensionDataFromDb
+	didData       DidDataFromDb
+}
+
+func newCachedNumRepository(repository NumRepository, ttl time.Duration, negativeTtl time.Duration, size int) *cachedNumRepository {
+	return &cachedNumRepository{
+		repository:  repository,
+		ttl:         ttl,
+		negativeTtl: negativeTtl,
+		size:        size,
+		entries:     make(map[string]*list.Element),
+		lru:         list.New(),
+	}
+}
+
+func (cache *cachedNumRepository) get(key string) (*numCacheEntry, bool) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	element, found := cache.entries[key]
+	if !found {
+		atomic.AddUint64(&cache.misses, 1)
+		return nil, false
+	}
+	entry := element.Value.(*numCacheEntry)
+	if time.Now().After(entry.expire) {
+		cache.lru.Remove(element)
+		delete(cache.entries, key)
+		atomic.AddUint64(&cache.misses, 1)
+		return nil, false
+	}
+	cache.lru.MoveToFront(element)
+	atomic.AddUint64(&cache.hits, 1)
+	return entry, true
+}
+
+func (cache *cachedNumRepository) set(entry *numCacheEntry, known bool) {
+	if known {
+		entry.expire = time.Now().Add(cache.ttl)
+	} else {
+		entry.expire = time.Now().Add(cache.negativeTtl)
+	}
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	if element, found := cache.entries[entry.key]; found {
+		element.Value = entry
+		cache.lru.MoveToFront(element)
+		return
+	}
+	cache.entries[entry.key] = cache.lru.PushFront(entry)
+	for cache.size > 0 && cache.lru.Len() > cache.size {
+		oldest := cache.lru.Back()
+		cache.lru.Remove(oldest)
+		delete(cache.entries, oldest.Value.(*numCacheEntry).key)
+	}
+}
+
+func (cache *cachedNumRepository) GetExtensionParamsByExtension(ctx context.Context, extension string) (ExtensionDataFromDb, error) {
+	key := "extension:" + extension
+	if entry, found := cache.get(key); found {
+		return entry.extensionData, nil
+	}
+	extensionData, err := cache.repository.GetExtensionParamsByExtension(ctx, extension)
+	if err != nil {
+		return extensionData, err
+	}
+	cache.set(&numCacheEntry{key: key, extensionData: extensionData}, extensionData.Num.Valid)
+	return extensionData, nil
+}
+
+func (cache *cachedNumRepository) GetDidParamsByDid(ctx context.Context, did string) (DidDataFromDb, error) {
+	key := "did:" + did
+	if entry, found := cache.get(key); found {
+		return entry.didData, nil
+	}
+	didData, err := cache.repository.GetDidParamsByDid(ctx, did)
+	if err != nil {
+		return didData, err
+	}
+	cache.set(&numCacheEntry{key: key, didData: didData}, didData.Sda.Valid)
+	return didData, nil
+}
+
+// Forget the lookups of num, return true if one of them was cached
+func (cache *cachedNumRepository) Invalidate(num string) bool {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	var invalidated bool
+	for _, key := range []string{"extension:" + num, "did:" + num} {
+		if element, found := cache.entries[key]; found {
+			cache.lru.Remove(element)
+			delete(cache.entries, key)
+			invalidated = true
+		}
+	}
+	return invalidated
+}
+
+// Forget all the lookups, return true if the cache was not empty
+func (cache *cachedNumRepository) InvalidateAll() bool {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	invalidated := cache.lru.Len() > 0
+	cache.entries = make(map[string]*list.Element)
+	cache.lru.Init()
+	return invalidated
+}
+
+func (cache *cachedNumRepository) Stats() (hits uint64, misses uint64, entries int) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	return atomic.LoadUint64(&cache.hits), atomic.LoadUint64(&cache.misses), cache.lru.Len()
+}
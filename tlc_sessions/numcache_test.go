@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// NumRepository counting the lookups reaching it
+type countingNumRepository struct {
+	NumRepository
+	lookups int
+}
+
+func (repository *countingNumRepository) GetExtensionParamsByExtension(ctx context.Context, extension string) (ExtensionDataFromDb, error) {
+	repository.lookups++
+	return repository.NumRepository.GetExtensionParamsByExtension(ctx, extension)
+}
+
+func TestCachedNumRepository(t *testing.T) {
+	repository := &countingNumRepository{NumRepository: newFakeNumRepository()}
+	cache := newCachedNumRepository(repository, time.Minute, time.Minute, 2)
+	for i := 0; i < 3; i++ {
+		getExtensionParamsByExtension(cache, "1001")
+		getExtensionParamsByExtension(cache, "33699999999")
+	}
+	if repository.lookups != 2 {
+		t.Fatalf("lookups = %d, want 2 (known and unknown numbers cached)", repository.lookups)
+	}
+	if hits, misses, entries := cache.Stats(); hits != 4 || misses != 2 || entries != 2 {
+		t.Fatalf("Stats() = %d, %d, %d, want 4, 2, 2", hits, misses, entries)
+	}
+	getExtensionParamsByExtension(cache, "1002")
+	getExtensionParamsByExtension(cache, "1001")
+	if repository.lookups != 4 {
+		t.Fatalf("lookups = %d, want 4 (1001 evicted as least recently used)", repository.lookups)
+	}
+	if !cache.Invalidate("1002") || cache.Invalidate("1002") {
+		t.Fatalf("Invalidate(1002) must be true only while 1002 is cached")
+	}
+}
+
+func TestCachedNumRepositoryExpire(t *testing.T) {
+	repository := &countingNumRepository{NumRepository: newFakeNumRepository()}
+	cache := newCachedNumRepository(repository, time.Minute, -time.Second, 10)
+	getExtensionParamsByExtension(cache, "33699999999")
+	getExtensionParamsByExtension(cache, "33699999999")
+	if repository.lookups != 2 {
+		t.Fatalf("lookups = %d, want 2 (negative entry expired)", repository.lookups)
+	}
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fetristan/tlc_sessions/sessionsservice"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Call state of the originated sessions until FreeSWITCH sends their events
+const originateCallState = "ORIGINATING"
+
+// Wait of the result of an originate job, the ringing of the first leg included
+const originateJobTimeout = 2 * time.Minute
+
+// One channel variable of an originate, quoted if its value has spaces
+func channelVariable(key string, value string) (string, error) {
+	if err := checkCommandArg("variable name", key, true); err != nil {
+		return "", err
+	}
+	if strings.ContainsAny(key, ",={}'") {
+		return "", status.Errorf(codes.InvalidArgument, "variable name %s must not contain , = { } or '", key)
+	}
+	if strings.ContainsAny(value, ",{}'") || strings.IndexFunc(value, func(r rune) bool { return r != ' ' && (r < ' ' || r == 0x7f) }) >= 0 {
+		return "", status.Errorf(codes.InvalidArgument, "value of %s must not contain , { } ' or control characters", key)
+	}
+	if strings.Contains(value, " ") {
+		value = "'" + value + "'"
+	}
+	return key + "=" + value, nil
+}
+
+// Connected FreeSWITCH of the pole to originate on, any connected one if no pole is given
+func originateConnIdx(pole string) (int, error) {
+	known := pole == ""
+	for connIdx, nodeStatus := range fsStatus {
+		if pole != "" && nodeStatus.pole != pole {
+			continue
+		}
+		known = true
+		if connIdx < len(fs) && fs[connIdx] != nil && fs[connIdx].Connected() {
+			return connIdx, nil
+		}
+	}
+	if !known {
+		return 0, status.Errorf(codes.InvalidArgument, "unknown pole %s", pole)
+	}
+	return 0, status.Errorf(codes.Unavailable, "no connected FreeSWITCH for pole %q", pole)
+}
+
+// Ring the extension first, then send it to the destination through the dialplan
+func originateCommand(uid string, originateId string, in *sessionsservice.OriginateRequest) (string, error) {
+	for name, value := range map[string]string{"extension": in.GetExtension(), "destination": in.GetDestination(), "context": in.GetContext()} {
+		if err := checkCommandArg(name, value, name != "context"); err != nil {
+			return "", err
+		}
+	}
+	if strings.ContainsAny(in.GetExtension(), "{},/") {
+		return "", status.Error(codes.InvalidArgument, "extension must not contain channel variables or a dial string")
+	}
+	values := map[string]string{
+		"origination_uuid": uid,
+		"tlc_originate_id": originateId,
+		"tlc_c2c":          "1",
+	}
+	if in.GetCallerIdNumber() != "" {
+		values["origination_caller_id_number"] = in.GetCallerIdNumber()
+	}
+	if in.GetCallerIdName() != "" {
+		values["origination_caller_id_name"] = in.GetCallerIdName()
+	}
+	for key, value := range in.GetVariables() {
+		if _, reserved := values[key]; reserved {
+			return "", status.Errorf(codes.InvalidArgument, "variable %s is set by tlc_sessions", key)
+		}
+		values[key] = value
+	}
+	var variables []string
+	for key, value := range values {
+		variable, err := channelVariable(key, value)
+		if err != nil {
+			return "", err
+		}
+		variables = append(variables, variable)
+	}
+	sort.Strings(variables)
+	dialplanContext := in.GetContext()
+	if dialplanContext == "" {
+		dialplanContext = "default"
+	}
+	return "originate {" + strings.Join(variables, ",") + "}user/" + in.GetExtension() + " " + in.GetDestination() + " XML " + dialplanContext, nil
+}
+
+// Wait the result of the originate job of the session uid, the session being removed if FreeSWITCH failed it
+func watchOriginateJob(uid string, command string, reply chan string, reason string) {
+	if reply == nil {
+		return
+	}
+	select {
+	case result := <-reply:
+		if err := apiReplyError(result, nil); err != nil {
+			log.Errorf("Originate : %s : %s", command, err)
+			activeSessions.Lock()
+			defer activeSessions.Unlock()
+			if session, sessionId, found := activeSessions.Get(uid, "", false, true); found {
+				session.CallState = "FAILED"
+				session.HangupReason = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(result), "-ERR"))
+				activeSessions.Update(sessionId, *session)
+			}
+			if removed, found := removeSessions(uid, "", reason); found {
+				endSessionLegs(removed, reason)
+			}
+		}
+	case <-time.After(originateJobTimeout):
+		log.Errorf("Originate : no result for %s", command)
+	}
+}
+
+// Start a click-to-call from GRPC, the session being created before the call so its uid can be tracked at once
+func (s *server) Originate(ctx context.Context, in *sessionsservice.OriginateRequest) (*sessionsservice.OriginateReply, error) {
+	log.Debugf("Received:Originate : %s -> %s / pole : %s", in.GetExtension(), in.GetDestination(), in.GetPole())
+	session := sessionsservice.Session{
+		CallerUid:   newUuid(),
+		OriginateId: newUuid(),
+		CallerNum:   in.GetExtension(),
+		CalleeNum:   in.GetDestination(),
+		CallState:   originateCallState,
+		DateStart:   time.Now(),
+		IsC2C:       true,
+	}
+	command, err := originateCommand(session.CallerUid, session.OriginateId, in)
+	if err != nil {
+		return nil, err
+	}
+	connIdx, err := originateConnIdx(in.GetPole())
+	if err != nil {
+		return nil, err
+	}
+	stampSessionNode(&session, connIdx)
+	activeSessions.Lock()
+	addSession(&session, "ORIGINATE")
+	activeSessions.Unlock()
+
+	log.Debugf("Originate : FreeSWITCH %d : %s", connIdx, command)
+	reply, err := fs[connIdx].SendBgapiCmd(command)
+	if err != nil {
+		activeSessions.Lock()
+		if removed, found := removeSessions(session.CallerUid, "", "ORIGINATE_FAILED"); found {
+			endSessionLegs(removed, "ORIGINATE_FAILED")
+		}
+		activeSessions.Unlock()
+		return nil, apiReplyError("", err)
+	}
+	go watchOriginateJob(session.CallerUid, command, reply, "ORIGINATE_FAILED")
+	return &sessionsservice.OriginateReply{Uid: session.CallerUid, OriginateId: session.OriginateId, Node: session.Node}, nil
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fetristan/tlc_sessions/sessionsservice"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestOriginateCommand(t *testing.T) {
+	command, err := originateCommand("uid", "job", &sessionsservice.OriginateRequest{
+		Extension:      "1001",
+		Destination:    "0612345678",
+		CallerIdNumber: "0123456789",
+		CallerIdName:   "Support Desk",
+		Variables:      map[string]string{"campaign": "spring"},
+	})
+	want := "originate {campaign=spring,origination_caller_id_name='Support Desk',origination_caller_id_number=0123456789,origination_uuid=uid,tlc_c2c=1,tlc_originate_id=job}user/1001 0612345678 XML default"
+	if err != nil || command != want {
+		t.Fatalf("got %q, %v\nwant %q", command, err, want)
+	}
+
+	for _, in := range []*sessionsservice.OriginateRequest{
+		{Extension: "1001"},
+		{Extension: "1001", Destination: "06 12"},
+		{Extension: "user/1001", Destination: "0612345678"},
+		{Extension: "1001", Destination: "0612345678", Variables: map[string]string{"a": "b,c=d"}},
+		{Extension: "1001", Destination: "0612345678", Variables: map[string]string{"origination_uuid": "other"}},
+	} {
+		if _, err := originateCommand("uid", "job", in); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%+v : %v, want InvalidArgument", in, err)
+		}
+	}
+}
+
+func TestOriginateConnIdx(t *testing.T) {
+	fsStatus = []*freeswitchStatus{{pole: "FR"}}
+	defer func() { fsStatus = nil }()
+	if _, err := originateConnIdx("BE"); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("unknown pole : %v", err)
+	}
+	if _, err := originateConnIdx("FR"); status.Code(err) != codes.Unavailable {
+		t.Errorf("pole not connected : %v", err)
+	}
+}
+
+func TestFailedOriginateEndsItsCall(t *testing.T) {
+	setTestCalls(t)
+	setTestSessions(t, nil)
+	session := sessionsservice.Session{CallerUid: "c2c", CallState: originateCallState, DateStart: time.Now()}
+	activeSessions.Lock()
+	addSession(&session, "ORIGINATE")
+	activeSessions.Unlock()
+	if activeCalls.callOf("c2c") == nil {
+		t.Fatalf("originated leg not tracked")
+	}
+
+	reply := make(chan string, 1)
+	reply <- "-ERR USER_BUSY\n"
+	watchOriginateJob("c2c", "originate", reply, "ORIGINATE_FAILED")
+	activeSessions.Lock()
+	defer activeSessions.Unlock()
+	if activeSessions.Len() != 0 || activeCalls.callOf("c2c") != nil || len(activeCalls.byId) != 0 {
+		t.Errorf("failed originate kept : %d sessions, calls %v", activeSessions.Len(), activeCalls.byId)
+	}
+}
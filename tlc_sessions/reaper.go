@@ -0,0 +1,176 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	events "github.com/fetristan/tlc_events"
+	"github.com/fetristan/tlc_sessions/sessionsservice"
+)
+
+// Call state of the sessions flagged by the reaper instead of removed
+const orphanCallState = "ORPHAN"
+
+// Reason of the session changes published by the reaper
+const reapReason = "REAPED"
+
+var errNodeNotConnected = errors.New("not connected")
+
+// Answer of uuid_exists on one node, unknown if the node could not be asked
+type uuidExistence int
+
+const (
+	uuidUnknown uuidExistence = iota
+	uuidExists
+	uuidMissing
+)
+
+func uuidExistsOnNode(connIdx int, uuid string) uuidExistence {
+	if connIdx >= len(fs) || fs[connIdx] == nil || !fs[connIdx].Connected() {
+		return uuidUnknown
+	}
+	result, err := fs[connIdx].SendApiCmd("uuid_exists " + uuid)
+	if err != nil {
+		log.Errorf("Reaper : FreeSWITCH %d error : %s", connIdx, err)
+		return uuidUnknown
+	}
+	if strings.TrimSpace(result) == "true" {
+		return uuidExists
+	}
+	return uuidMissing
+}
+
+// Uuids of the channels alive on the node
+func nodeChannelUuids(connIdx int) (map[string]bool, error) {
+	if connIdx >= len(fs) || fs[connIdx] == nil || !fs[connIdx].Connected() {
+		return nil, errNodeNotConnected
+	}
+	result, err := fs[connIdx].SendApiCmd("show channels as json")
+	if err != nil {
+		return nil, err
+	}
+	var channels Channels
+	if err := json.Unmarshal([]byte(result), &channels); err != nil {
+		return nil, err
+	}
+	uuids := make(map[string]bool, len(channels.Rows))
+	for _, channel := range channels.Rows {
+		uuids[channel.Uuid] = true
+	}
+	return uuids, nil
+}
+
+// Existence of the uuids answered from one listing of the channels of each node, each node being listed at most once
+func listedUuidExists(list func(connIdx int) (map[string]bool, error)) func(connIdx int, uuid string) uuidExistence {
+	listed := make(map[int]map[string]bool)
+	asked := make(map[int]bool)
+	return func(connIdx int, uuid string) uuidExistence {
+		if !asked[connIdx] {
+			asked[connIdx] = true
+			uuids, err := list(connIdx)
+			if err != nil {
+				log.Errorf("Reaper : FreeSWITCH %d error : %s", connIdx, err)
+			} else {
+				listed[connIdx] = uuids
+			}
+		}
+		uuids, known := listed[connIdx]
+		if !known {
+			return uuidUnknown
+		}
+		if uuids[uuid] {
+			return uuidExists
+		}
+		return uuidMissing
+	}
+}
+
+// Reap the sessions older than age every interval until ctx is done, the nodes being listed once per cycle
+func reapStaleSessionsPeriodically(ctx context.Context, interval time.Duration, age time.Duration, flagOnly bool) {
+	if interval <= 0 || age <= 0 {
+		return
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+		reapStaleSessions(age, flagOnly, listedUuidExists(nodeChannelUuids))
+	}
+}
+
+// A session is orphan if none of its uuids exists on its node, or on any node of its pole, every node having answered
+func isOrphanSession(session *sessionsservice.Session, exists func(connIdx int, uuid string) uuidExistence) bool {
+	connIdxs := sessionConnections(session)
+	if len(connIdxs) == 0 {
+		return false
+	}
+	for _, uuid := range []string{session.CallerUid, session.CalleeUid} {
+		if uuid == "" {
+			continue
+		}
+		for _, connIdx := range connIdxs {
+			if exists(connIdx, uuid) != uuidMissing {
+				return false
+			}
+		}
+	}
+	return session.CallerUid != "" || session.CalleeUid != ""
+}
+
+// Check the sessions started before age and remove the orphan ones, or flag them if flagOnly.
+// The uuids are checked without the sessions locked, so a session changed meanwhile is kept.
+func reapStaleSessions(age time.Duration, flagOnly bool, exists func(connIdx int, uuid string) uuidExistence) []sessionsservice.Session {
+	if age <= 0 {
+		return nil
+	}
+	before := time.Now().Add(-age)
+	activeSessions.Lock()
+	var candidates []sessionsservice.Session
+	for _, session := range activeSessions.List() {
+		if session.DateStart.Before(before) && session.CallState != orphanCallState {
+			candidates = append(candidates, session)
+		}
+	}
+	activeSessions.Unlock()
+
+	orphans := make(map[string]sessionsservice.Session)
+	for i := range candidates {
+		if isOrphanSession(&candidates[i], exists) {
+			orphans[sessionUids(&candidates[i])] = candidates[i]
+		}
+	}
+	if len(orphans) == 0 {
+		return nil
+	}
+
+	activeSessions.Lock()
+	defer activeSessions.Unlock()
+	var reaped []sessionsservice.Session
+	for _, orphan := range orphans {
+		session, sessionId, found := activeSessions.Get(orphan.CallerUid, orphan.CalleeUid, true, false)
+		if !found || session.CallState != orphan.CallState || !session.DateStart.Equal(orphan.DateStart) {
+			continue
+		}
+		if flagOnly {
+			session.CallState = orphanCallState
+			updateSession(sessionId, session, reapReason)
+		} else {
+			removed := activeSessions.RemoveWhere(func(candidate *sessionsservice.Session) bool {
+				return candidate.CallerUid == session.CallerUid && candidate.CalleeUid == session.CalleeUid
+			})
+			for i := range removed {
+				endSessionLegs(&removed[i], reapReason)
+				finishSession(&removed[i], events.Event{EventName: reapReason, HangupCause: reapReason, HangupTime: time.Now()})
+			}
+		}
+		sessionsReaped.Inc()
+		log.Errorf("Reaper : ORPHAN SESSION %s (%s -> %s) started at %s, flagged only : %t", sessionUids(session), session.CallerNum, session.CalleeNum, session.DateStart, flagOnly)
+		reaped = append(reaped, *session)
+	}
+	return reaped
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fetristan/tlc_sessions/sessionsservice"
+)
+
+// Existence answering from a map of the uuids alive on each node, nodes missing from it being unreachable
+func fakeUuidExists(alive map[int]map[string]bool) func(connIdx int, uuid string) uuidExistence {
+	return func(connIdx int, uuid string) uuidExistence {
+		uuids, reachable := alive[connIdx]
+		if !reachable {
+			return uuidUnknown
+		}
+		if uuids[uuid] {
+			return uuidExists
+		}
+		return uuidMissing
+	}
+}
+
+// Queue receiving the CDRs written during the test
+func setTestCdrQueue(t *testing.T) chan *Cdr {
+	previous := cdrQueue
+	cdrQueue = make(chan *Cdr, 8)
+	t.Cleanup(func() { cdrQueue = previous })
+	return cdrQueue
+}
+
+func TestReapStaleSessions(t *testing.T) {
+	cdrs := setTestCdrQueue(t)
+	previousEnded := endedSessions
+	endedSessions = sessionsservice.NewStore()
+	defer func() { endedSessions = previousEnded }()
+	fsStatus = []*freeswitchStatus{{pole: "FR"}, {pole: "ES"}}
+	defer func() { fsStatus = nil }()
+	old := time.Now().Add(-2 * time.Hour)
+	setTestSessions(t, []sessionsservice.Session{
+		{CallerUid: "alive", CalleeUid: "gone", Pole: "FR", DateStart: old},
+		{CallerUid: "ghost", CalleeUid: "ghost-b", Pole: "FR", DateStart: old},
+		{CallerUid: "recent", Pole: "FR", DateStart: time.Now()},
+		{CallerUid: "unreachable", Pole: "ES", DateStart: old},
+	})
+	exists := fakeUuidExists(map[int]map[string]bool{0: {"alive": true}})
+
+	reaped := reapStaleSessions(time.Hour, false, exists)
+	if len(reaped) != 1 || reaped[0].CallerUid != "ghost" {
+		t.Fatalf("reaped = %+v, want the ghost session only", reaped)
+	}
+	activeSessions.Lock()
+	count := activeSessions.Len()
+	activeSessions.Unlock()
+	if count != 3 {
+		t.Errorf("%d sessions left, want 3", count)
+	}
+	if len(cdrs) != 1 {
+		t.Fatalf("%d CDRs written, want the one of the ghost session", len(cdrs))
+	}
+	if cdr := <-cdrs; cdr.CallerUid != "ghost" || cdr.HangupCause != reapReason {
+		t.Errorf("cdr = %+v", cdr)
+	}
+	if ghost, found := getEndedSession("ghost", "ghost-b", true, false); !found || ghost.HangupReason != reapReason {
+		t.Errorf("reaped session = %+v, %v, want it ended", ghost, found)
+	}
+}
+
+func TestReapStaleSessionsFlagOnly(t *testing.T) {
+	fsStatus = []*freeswitchStatus{{pole: "FR"}}
+	defer func() { fsStatus = nil }()
+	setTestSessions(t, []sessionsservice.Session{
+		{CallerUid: "ghost", Pole: "FR", DateStart: time.Now().Add(-2 * time.Hour)},
+	})
+	exists := fakeUuidExists(map[int]map[string]bool{0: {}})
+	if reaped := reapStaleSessions(time.Hour, true, exists); len(reaped) != 1 {
+		t.Fatalf("reaped = %+v, want the ghost session", reaped)
+	}
+	activeSessions.Lock()
+	session, _, found := activeSessions.Get("ghost", "", true, false)
+	activeSessions.Unlock()
+	if !found || session.CallState != orphanCallState {
+		t.Fatalf("flagged session = %+v, %v, want call state %s", session, found, orphanCallState)
+	}
+	if reaped := reapStaleSessions(time.Hour, true, exists); len(reaped) != 0 {
+		t.Errorf("flagged session reaped again : %+v", reaped)
+	}
+}
+
+func TestListedUuidExists(t *testing.T) {
+	listed := make(map[int]int)
+	exists := listedUuidExists(func(connIdx int) (map[string]bool, error) {
+		listed[connIdx]++
+		if connIdx == 1 {
+			return nil, errNodeNotConnected
+		}
+		return map[string]bool{"alive": true}, nil
+	})
+	for i := 0; i < 3; i++ {
+		if got := exists(0, "alive"); got != uuidExists {
+			t.Errorf("exists(0, alive) = %d", got)
+		}
+		if got := exists(0, "gone"); got != uuidMissing {
+			t.Errorf("exists(0, gone) = %d", got)
+		}
+		if got := exists(1, "alive"); got != uuidUnknown {
+			t.Errorf("exists(1, alive) = %d", got)
+		}
+	}
+	if listed[0] != 1 || listed[1] != 1 {
+		t.Errorf("nodes listed %v times, want once each", listed)
+	}
+}
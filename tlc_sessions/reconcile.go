@@ -0,0 +1,187 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	events "github.com/fetristan/tlc_events"
+	"github.com/fetristan/tlc_sessions/sessionsservice"
+)
+
+// What a reconciliation changed
+type reconcileReport struct {
+	Added    []string
+	Restored []string
+	Removed  []string
+	Stale    []string
+	Complete bool
+}
+
+func (report reconcileReport) String() string {
+	return "added : " + strings.Join(report.Added, ", ") +
+		" / restored : " + strings.Join(report.Restored, ", ") +
+		" / removed : " + strings.Join(report.Removed, ", ") +
+		" / stale in redis : " + strings.Join(report.Stale, ", ") +
+		" / complete : " + strconv.FormatBool(report.Complete)
+}
+
+func sessionUids(session *sessionsservice.Session) string {
+	return session.CallerUid + "|" + session.CalleeUid
+}
+
+func epochStrToTime(epoch string) time.Time {
+	seconds, err := strconv.ParseInt(epoch, 10, 64)
+	if err != nil || seconds == 0 {
+		return time.Time{}
+	}
+	return time.Unix(seconds, 0)
+}
+
+// Sessions of the calls and of the channels not in a call (parked, IVR...) of one node
+func liveSessionsFromShow(calls *Livecalls, channels *Channels, connIdx int) []sessionsservice.Session {
+	var liveSessions []sessionsservice.Session
+	inCall := make(map[string]bool)
+	for _, call := range calls.Rows {
+		inCall[call.Uuid] = true
+		inCall[call.BUuid] = true
+		liveSessions = append(liveSessions, sessionsservice.Session{
+			CallerUid:   call.Uuid,
+			CalleeUid:   call.BUuid,
+			CallerNum:   call.CidNum,
+			CalleeNum:   call.Dest,
+			FsDirection: call.Direction,
+			CallState:   call.Callstate,
+			DateStart:   epochStrToTime(call.CreatedEpoch),
+		})
+		stampSessionNode(&liveSessions[len(liveSessions)-1], connIdx)
+	}
+	for _, channel := range channels.Rows {
+		if inCall[channel.Uuid] {
+			continue
+		}
+		liveSessions = append(liveSessions, sessionsservice.Session{
+			CallerUid:   channel.Uuid,
+			CallerNum:   channel.CidNum,
+			CalleeNum:   channel.Dest,
+			FsDirection: channel.Direction,
+			CallState:   channel.Callstate,
+			DateStart:   epochStrToTime(channel.CreatedEpoch),
+		})
+		stampSessionNode(&liveSessions[len(liveSessions)-1], connIdx)
+	}
+	return liveSessions
+}
+
+// Live sessions of one node, from one show calls and one show channels
+func getNodeLiveSessions(connIdx int) ([]sessionsservice.Session, error) {
+	var calls Livecalls
+	var channels Channels
+	result, err := fs[connIdx].SendApiCmd("show calls as json")
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(result), &calls); err != nil {
+		return nil, err
+	}
+	result, err = fs[connIdx].SendApiCmd("show channels as json")
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(result), &channels); err != nil {
+		return nil, err
+	}
+	return liveSessionsFromShow(&calls, &channels, connIdx), nil
+}
+
+// Rebuild the sessions from the channels alive on every node: the missing ones are added, restored from
+// candidates (the sessions read from redis) when they match, and the ones alive nowhere are removed.
+// Nothing is removed unless every node answered.
+func reconcileSessions(candidates []sessionsservice.Session) reconcileReport {
+	since := time.Now()
+	var liveSessions []sessionsservice.Session
+	complete := len(fs) > 0
+	for connIdx := range fs {
+		if fs[connIdx] == nil || !fs[connIdx].Connected() {
+			complete = false
+			continue
+		}
+		nodeSessions, err := getNodeLiveSessions(connIdx)
+		if err != nil {
+			log.Errorf("Reconcile : FreeSWITCH %d error : %s", connIdx, err)
+			complete = false
+			continue
+		}
+		liveSessions = append(liveSessions, nodeSessions...)
+	}
+	return applyReconcile(liveSessions, candidates, complete, since)
+}
+
+func applyReconcile(liveSessions []sessionsservice.Session, candidates []sessionsservice.Session, complete bool, since time.Time) reconcileReport {
+	report := reconcileReport{Complete: complete}
+	candidatesStore := sessionsservice.NewStore()
+	candidatesStore.Set(candidates)
+	liveUids := make(map[string]bool)
+	activeSessions.Lock()
+	defer activeSessions.Unlock()
+	for i := range liveSessions {
+		live := &liveSessions[i]
+		liveUids[live.CallerUid] = true
+		if live.CalleeUid != "" {
+			liveUids[live.CalleeUid] = true
+		}
+		// A standalone channel may be tracked with another party, whatever the side it is on
+		if _, _, found := activeSessions.Get(live.CallerUid, live.CalleeUid, false, live.CalleeUid == ""); found {
+			continue
+		}
+		// Only a candidate of the same pair is restored, one sharing a single uid is stale
+		if candidate, candidateId, found := candidatesStore.Get(live.CallerUid, live.CalleeUid, true, false); found {
+			candidatesStore.RemoveId(candidateId)
+			addSession(candidate, "RECONCILE")
+			report.Restored = append(report.Restored, sessionUids(candidate))
+			continue
+		}
+		addSession(live, "RECONCILE")
+		report.Added = append(report.Added, sessionUids(live))
+	}
+	if !complete {
+		return report
+	}
+	removed := activeSessions.RemoveWhere(func(session *sessionsservice.Session) bool {
+		return session.DateStart.Before(since) && !liveUids[session.CallerUid] && !liveUids[session.CalleeUid]
+	})
+	for i := range removed {
+		endSessionLegs(&removed[i], "RECONCILE")
+		finishSession(&removed[i], events.Event{EventName: "RECONCILE", HangupCause: "RECONCILE", HangupTime: time.Now()})
+		report.Removed = append(report.Removed, sessionUids(&removed[i]))
+	}
+	for _, stale := range candidatesStore.List() {
+		if _, _, found := activeSessions.Get(stale.CallerUid, stale.CalleeUid, true, false); !found {
+			delRedisDatabaseSession(&stale)
+			report.Stale = append(report.Stale, sessionUids(&stale))
+		}
+	}
+	return report
+}
+
+// Reconcile the sessions every interval until ctx is done, nothing is done if no interval is configured
+func reconcileSessionsPeriodically(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+		report := reconcileSessions(nil)
+		if len(report.Added) > 0 || len(report.Removed) > 0 {
+			log.Errorf("Reconcile : DRIFT HEALED : %s", report)
+		} else {
+			log.Debugf("Reconcile : %s", report)
+		}
+	}
+}
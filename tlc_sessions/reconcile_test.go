@@ -0,0 +1,119 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/fetristan/tlc_sessions/sessionsservice"
+)
+
+const showCallsJson = `{"row_count":1,"rows":[{"uuid":"a1","direction":"inbound","created_epoch":"1700000000","cid_num":"0601020304","dest":"1001","callstate":"ACTIVE","b_uuid":"b1"}]}`
+
+const showChannelsJson = `{"row_count":3,"rows":[{"uuid":"a1","created_epoch":"1700000000"},{"uuid":"b1","created_epoch":"1700000000"},{"uuid":"p1","direction":"inbound","created_epoch":"1700000100","cid_num":"0605060708","dest":"3000","callstate":"ACTIVE"}]}`
+
+func TestLiveSessionsFromShow(t *testing.T) {
+	var calls Livecalls
+	var channels Channels
+	if err := json.Unmarshal([]byte(showCallsJson), &calls); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(showChannelsJson), &channels); err != nil {
+		t.Fatal(err)
+	}
+	fsStatus = []*freeswitchStatus{{host: "fs1:8021", pole: "FR"}}
+	defer func() { fsStatus = nil }()
+	live := liveSessionsFromShow(&calls, &channels, 0)
+	if len(live) != 2 {
+		t.Fatalf("live sessions = %+v, want the call and the parked channel", live)
+	}
+	if live[0].CallerUid != "a1" || live[0].CalleeUid != "b1" || live[0].CalleeNum != "1001" || live[0].DateStart.Unix() != 1700000000 || live[0].Pole != "FR" || live[0].Node != "fs1:8021" {
+		t.Errorf("call session = %+v", live[0])
+	}
+	if live[1].CallerUid != "p1" || live[1].CalleeUid != "" || live[1].CallerNum != "0605060708" {
+		t.Errorf("parked session = %+v", live[1])
+	}
+}
+
+func setTestSessions(t *testing.T, list []sessionsservice.Session) {
+	activeSessions.Lock()
+	previous := activeSessions.List()
+	activeSessions.Set(list)
+	activeSessions.Unlock()
+	t.Cleanup(func() {
+		activeSessions.Lock()
+		activeSessions.Set(previous)
+		activeSessions.Unlock()
+	})
+}
+
+func TestApplyReconcile(t *testing.T) {
+	before := time.Now().Add(-time.Minute)
+	setTestSessions(t, []sessionsservice.Session{
+		{CallerUid: "a1", CalleeUid: "b1", DateStart: before},
+		{CallerUid: "ghost", CalleeUid: "ghost-b", DateStart: before},
+		{CallerUid: "x1", CalleeUid: "p3", DateStart: before},
+	})
+	live := []sessionsservice.Session{
+		{CallerUid: "a1", CalleeUid: "b1"},
+		{CallerUid: "p3"},
+		{CallerUid: "a2", CalleeUid: "b2", CallerNum: "live"},
+		{CallerUid: "p1"},
+	}
+	candidates := []sessionsservice.Session{
+		{CallerUid: "b2", CalleeUid: "a2", CallerNum: "redis"},
+		{CallerUid: "old", CalleeUid: "old-b"},
+	}
+	report := applyReconcile(live, candidates, true, time.Now())
+	if len(report.Added) != 1 || report.Added[0] != "p1|" {
+		t.Errorf("added = %v, want [p1|]", report.Added)
+	}
+	if len(report.Restored) != 1 || report.Restored[0] != "b2|a2" {
+		t.Errorf("restored = %v, want [b2|a2]", report.Restored)
+	}
+	if len(report.Removed) != 1 || report.Removed[0] != "ghost|ghost-b" {
+		t.Errorf("removed = %v, want [ghost|ghost-b]", report.Removed)
+	}
+	if len(report.Stale) != 1 || report.Stale[0] != "old|old-b" {
+		t.Errorf("stale = %v, want [old|old-b]", report.Stale)
+	}
+	if ghost, found := getEndedSession("ghost", "ghost-b", true, false); !found || ghost.HangupReason != "RECONCILE" {
+		t.Errorf("removed session = %+v, %v, want it ended", ghost, found)
+	}
+	activeSessions.Lock()
+	defer activeSessions.Unlock()
+	if session, _, found := activeSessions.Get("a2", "b2", true, false); !found || session.CallerNum != "redis" {
+		t.Errorf("restored session = %+v, %v, want the one from redis", session, found)
+	}
+}
+
+func TestApplyReconcileIncomplete(t *testing.T) {
+	setTestSessions(t, []sessionsservice.Session{
+		{CallerUid: "ghost", CalleeUid: "ghost-b", DateStart: time.Now().Add(-time.Minute)},
+	})
+	report := applyReconcile(nil, nil, false, time.Now())
+	if len(report.Removed) != 0 {
+		t.Errorf("removed = %v while a node did not answer", report.Removed)
+	}
+}
+
+func TestApplyReconcileCandidateOfAnotherPair(t *testing.T) {
+	setTestSessions(t, nil)
+	live := []sessionsservice.Session{{CallerUid: "a", CalleeUid: "b", CallerNum: "live"}}
+	candidates := []sessionsservice.Session{
+		{CallerUid: "a", CalleeUid: "c", CallerNum: "redis"},
+		{CallerUid: "a", CallerNum: "redis"},
+	}
+	report := applyReconcile(live, candidates, true, time.Now())
+	if len(report.Restored) != 0 || len(report.Added) != 1 || report.Added[0] != "a|b" {
+		t.Errorf("restored = %v, added = %v, want a|b added from the live channel", report.Restored, report.Added)
+	}
+	if len(report.Stale) != 2 {
+		t.Errorf("stale = %v, want both candidates", report.Stale)
+	}
+	activeSessions.Lock()
+	defer activeSessions.Unlock()
+	if _, _, found := activeSessions.Get("a", "c", true, false); found || activeSessions.Len() != 1 {
+		t.Errorf("stale callee c restored, %d sessions", activeSessions.Len())
+	}
+}
@@ -3,76 +3,152 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"sort"
+	"time"
 
 	"github.com/fetristan/tlc_sessions/sessionsservice"
 	"github.com/go-redis/redis/v8"
 )
 
+// Key of the whole sessions list written by the previous versions, read once to migrate it
+const redisLegacySessionsKey = "tlc_sessions"
+
+// Prefix of the keys holding one session each
+const redisSessionKeyPrefix = "tlc_sessions:"
+
+// Used when redis session_ttl is not configured
+const redisDefaultSessionTtl = 12 * time.Hour
+
+// Attempts to read the sessions at startup before starting without them
+const redisReadAttempts = 5
+
 var ctx = context.Background()
 var rdb *redis.Client
+var redisSessionTtl time.Duration
+
+var errRedisNotReady = errors.New("redis not ready")
 
-func connectToRedisDatabase(host string, port string, password string, db int) {
+func connectToRedisDatabase(host string, port string, password string, db int, sessionTtl int) {
 	rdb = redis.NewClient(&redis.Options{
 		Addr:     host + ":" + port,
 		Password: password,
 		DB:       db,
 	})
+	redisSessionTtl = time.Duration(sessionTtl) * time.Second
+	if redisSessionTtl <= 0 {
+		redisSessionTtl = redisDefaultSessionTtl
+	}
 }
 
-/*func setRedisDatabaseSession(session sessionsservice.Session) {
+func redisSessionKey(session *sessionsservice.Session) string {
+	return redisSessionKeyPrefix + session.CallerUid + "|" + session.CalleeUid
+}
+
+// Upsert one session, its ttl is refreshed on each write so only leaked sessions expire. The write is queued,
+// redis being written in the background and retried while it is down.
+func setRedisDatabaseSession(session *sessionsservice.Session) {
 	jsonStr, _ := json.Marshal(session)
-	err := rdb.Set(ctx, session.CallerUid+session.CalleeUid, jsonStr, 0).Err()
-	if err != nil {
-		panic(err)
-	}
-	log.Debugf("Redis : SET : %s", jsonStr)
-}*/
+	redisQueue.push(redisSessionKey(session), jsonStr)
+}
 
-func setRedisDatabaseSessions(sessions []sessionsservice.Session) {
-	_, err := rdb.Del(ctx, "tlc_sessions").Result()
-	if err != nil {
-		panic(err)
-	}
-	jsonStr, _ := json.Marshal(sessions)
-	err = rdb.Set(ctx, "tlc_sessions", jsonStr, 0).Err()
-	if err != nil {
-		panic(err)
+// Write all the sessions at once, used at shutdown
+func flushRedisDatabaseSessions(flushCtx context.Context, sessions []sessionsservice.Session) error {
+	writes := make([]*redisWrite, 0, len(sessions))
+	for i := range sessions {
+		jsonStr, _ := json.Marshal(&sessions[i])
+		writes = append(writes, &redisWrite{key: redisSessionKey(&sessions[i]), value: jsonStr})
 	}
+	return writeRedisBatch(flushCtx, writes)
 }
 
+func delRedisDatabaseSession(session *sessionsservice.Session) {
+	redisQueue.push(redisSessionKey(session), nil)
+}
+
+// Get all the sessions saved in redis, retrying with backoff if redis is down. The bool is true if redis could not be read.
 func getRedisDatabaseSessions() ([]sessionsservice.Session, bool) {
-	val, err := rdb.Get(ctx, "tlc_sessions").Result()
-	var redisSessions []sessionsservice.Session
-	if err == redis.Nil {
-		log.Debugf("Redis : SESSIONS NOT FOUND")
-	} else if err != nil {
-		panic(err)
-	} else {
-		log.Debugf("Redis : FOUND : %s", val)
+	backoff := fibDuration(time.Second, redisMaxRetryInterval)
+	for attempt := 1; ; attempt++ {
+		redisSessions, err := readRedisDatabaseSessions()
+		if err == nil {
+			return redisSessions, false
+		}
+		setRedisDegraded(err)
+		if attempt == redisReadAttempts {
+			log.Errorf("Redis : SESSIONS NOT READ after %d attempts : %s", attempt, err)
+			return nil, true
+		}
+		time.Sleep(backoff())
 	}
-	json.Unmarshal([]byte(val), &redisSessions)
-	return redisSessions, (err != redis.Nil && err != nil)
 }
 
-/*func getRedisDatabaseSession(session sessionsservice.Session) (sessionsservice.Session, bool) {
-	val, err := rdb.Get(ctx, session.CallerUid+session.CalleeUid).Result()
-	var redisSession sessionsservice.Session
-	if err == redis.Nil {
-		log.Debugf("Redis : NOT FOUND %s", session.CallerUid+session.CalleeUid)
-	} else if err != nil {
-		panic(err)
-	} else {
-		log.Debugf("Redis : FOUND : %s", val)
+// Read all the sessions saved in redis, migrating the legacy sessions list to one key per session if it still exists
+func readRedisDatabaseSessions() ([]sessionsservice.Session, error) {
+	if rdb == nil {
+		return nil, errRedisNotReady
+	}
+	var redisSessions []sessionsservice.Session
+	keys := make(map[string]bool)
+	iter := rdb.Scan(ctx, 0, redisSessionKeyPrefix+"*", 100).Iterator()
+	for iter.Next(ctx) {
+		val, err := rdb.Get(ctx, iter.Val()).Result()
+		if err == redis.Nil {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		var redisSession sessionsservice.Session
+		if err := json.Unmarshal([]byte(val), &redisSession); err != nil {
+			log.Errorf("Redis : INVALID SESSION %s : %s", iter.Val(), err)
+			continue
+		}
+		redisSessions = append(redisSessions, redisSession)
+		keys[iter.Val()] = true
+	}
+	if err := iter.Err(); err != nil {
+		return nil, err
 	}
-	json.Unmarshal([]byte(val), &redisSession)
-	return redisSession, err != redis.Nil
+
+	val, err := rdb.Get(ctx, redisLegacySessionsKey).Result()
+	if err != nil && err != redis.Nil {
+		return nil, err
+	} else if err == nil {
+		log.Debugf("Redis : LEGACY SESSIONS FOUND : %s", val)
+		var legacySessions []sessionsservice.Session
+		if err := json.Unmarshal([]byte(val), &legacySessions); err != nil {
+			//Kept to be migrated by hand rather than lost
+			log.Errorf("Redis : INVALID LEGACY SESSIONS %s, KEPT : %s", redisLegacySessionsKey, err)
+			return sortRedisSessions(redisSessions), nil
+		}
+		var migrated []sessionsservice.Session
+		for i := range legacySessions {
+			if !keys[redisSessionKey(&legacySessions[i])] {
+				migrated = append(migrated, legacySessions[i])
+				keys[redisSessionKey(&legacySessions[i])] = true
+			}
+		}
+		//Written before the legacy list is deleted, so a failure keeps it for the next attempt
+		if err := flushRedisDatabaseSessions(ctx, migrated); err != nil {
+			return nil, err
+		}
+		if err := rdb.Del(ctx, redisLegacySessionsKey).Err(); err != nil {
+			return nil, err
+		}
+		redisSessions = append(redisSessions, migrated...)
+	}
+	return sortRedisSessions(redisSessions), nil
 }
 
-func delRedisDatabaseSession(session sessionsservice.Session) {
-	val, err := rdb.Del(ctx, session.CallerUid+session.CalleeUid).Result()
-	if err != nil {
-		panic(err)
-	} else {
-		log.Debugf("Redis : DELETE : %s", val)
+// Sessions read from redis in the order they started
+func sortRedisSessions(redisSessions []sessionsservice.Session) []sessionsservice.Session {
+	if len(redisSessions) == 0 {
+		log.Debugf("Redis : SESSIONS NOT FOUND")
+		return redisSessions
 	}
-}*/
+	sort.SliceStable(redisSessions, func(i, j int) bool {
+		return redisSessions[i].DateStart.Before(redisSessions[j].DateStart)
+	})
+	log.Debugf("Redis : FOUND : %d sessions", len(redisSessions))
+	return redisSessions
+}
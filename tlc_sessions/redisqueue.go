@@ -0,0 +1,193 @@
+package main
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// Maximum wait between two writes while redis is down
+const redisMaxRetryInterval = 30 * time.Second
+
+// Timeout of one write of the queued sessions
+const redisWriteTimeout = 5 * time.Second
+
+// Pending write of one key, a nil value deleting it
+type redisWrite struct {
+	key   string
+	value []byte
+}
+
+// Write-behind queue of the sessions: the handlers never wait for redis, the last write of each key
+// is kept until redis accepts it, in the order the keys were first written
+type redisWriteQueue struct {
+	mutex   sync.Mutex
+	pending map[string]*redisWrite
+	order   []string
+	wake    chan struct{}
+}
+
+var redisQueue = newRedisWriteQueue()
+
+// State of redis as seen by the writer, degraded while the queued writes fail
+var redisState struct {
+	mutex     sync.Mutex
+	degraded  bool
+	lastError error
+}
+
+var redisWriterCancel context.CancelFunc
+var redisWriterDone chan struct{}
+
+func newRedisWriteQueue() *redisWriteQueue {
+	return &redisWriteQueue{pending: make(map[string]*redisWrite), wake: make(chan struct{}, 1)}
+}
+
+func (queue *redisWriteQueue) push(key string, value []byte) {
+	queue.mutex.Lock()
+	if _, exist := queue.pending[key]; !exist {
+		queue.order = append(queue.order, key)
+	}
+	queue.pending[key] = &redisWrite{key: key, value: value}
+	queue.mutex.Unlock()
+	select {
+	case queue.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Take all the pending writes
+func (queue *redisWriteQueue) take() []*redisWrite {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	writes := make([]*redisWrite, 0, len(queue.order))
+	for _, key := range queue.order {
+		writes = append(writes, queue.pending[key])
+	}
+	queue.pending = make(map[string]*redisWrite)
+	queue.order = nil
+	return writes
+}
+
+// Put back failed writes before the pending ones, unless their key was written again since
+func (queue *redisWriteQueue) requeue(writes []*redisWrite) {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	var order []string
+	for _, write := range writes {
+		if _, exist := queue.pending[write.key]; !exist {
+			queue.pending[write.key] = write
+			order = append(order, write.key)
+		}
+	}
+	queue.order = append(order, queue.order...)
+}
+
+func (queue *redisWriteQueue) len() int {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	return len(queue.order)
+}
+
+func writeRedisBatch(writeCtx context.Context, writes []*redisWrite) error {
+	if rdb == nil {
+		return errRedisNotReady
+	}
+	defer observeRedisWrite("write", time.Now())
+	_, err := rdb.Pipelined(writeCtx, func(pipe redis.Pipeliner) error {
+		for _, write := range writes {
+			if write.value == nil {
+				pipe.Del(writeCtx, write.key)
+			} else {
+				pipe.Set(writeCtx, write.key, write.value, redisSessionTtl)
+			}
+		}
+		return nil
+	})
+	return err
+}
+
+func setRedisDegraded(err error) {
+	redisState.mutex.Lock()
+	defer redisState.mutex.Unlock()
+	if err != nil {
+		redisWriteFailures.Inc()
+		if !redisState.degraded {
+			log.Errorf("Redis : DEGRADED, sessions kept in memory and queued : %s", err)
+		}
+	} else if redisState.degraded {
+		log.Debugf("Redis : BACK, queued sessions written")
+	}
+	redisState.degraded = err != nil
+	redisState.lastError = err
+	if redisState.degraded {
+		redisDegraded.Set(1)
+	} else {
+		redisDegraded.Set(0)
+	}
+}
+
+func getRedisDegraded() (bool, error) {
+	redisState.mutex.Lock()
+	defer redisState.mutex.Unlock()
+	return redisState.degraded, redisState.lastError
+}
+
+// Start writing the queued sessions into redis
+func startRedisWriter() {
+	writerCtx, cancel := context.WithCancel(context.Background())
+	redisWriterCancel = cancel
+	redisWriterDone = make(chan struct{})
+	go runRedisWriter(writerCtx)
+}
+
+// Write the queued sessions until ctx is done, retrying with backoff while redis is down
+func runRedisWriter(writerCtx context.Context) {
+	defer close(redisWriterDone)
+	backoff := fibDuration(time.Second, redisMaxRetryInterval)
+	for {
+		select {
+		case <-writerCtx.Done():
+			return
+		case <-redisQueue.wake:
+		}
+		for writes := redisQueue.take(); len(writes) > 0; writes = redisQueue.take() {
+			batchCtx, cancel := context.WithTimeout(writerCtx, redisWriteTimeout)
+			err := writeRedisBatch(batchCtx, writes)
+			cancel()
+			setRedisDegraded(err)
+			if err == nil {
+				backoff = fibDuration(time.Second, redisMaxRetryInterval)
+				continue
+			}
+			redisQueue.requeue(writes)
+			select {
+			case <-writerCtx.Done():
+				return
+			case <-time.After(backoff()):
+			}
+		}
+	}
+}
+
+// Stop the writer and write what is still queued before ctx is done
+func stopRedisWriter(stopCtx context.Context) error {
+	if redisWriterCancel == nil {
+		return nil
+	}
+	redisWriterCancel()
+	if err := waitUntil(stopCtx, func() { <-redisWriterDone }); err != nil {
+		return err
+	}
+	writes := redisQueue.take()
+	if len(writes) == 0 {
+		return nil
+	}
+	if err := writeRedisBatch(stopCtx, writes); err != nil {
+		redisQueue.requeue(writes)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"testing"
+)
+
+func queueKeys(writes []*redisWrite) []string {
+	var keys []string
+	for _, write := range writes {
+		keys = append(keys, write.key)
+	}
+	return keys
+}
+
+func TestRedisWriteQueueCoalesces(t *testing.T) {
+	queue := newRedisWriteQueue()
+	queue.push("a", []byte("1"))
+	queue.push("b", []byte("1"))
+	queue.push("a", nil)
+	if queue.len() != 2 {
+		t.Fatalf("len() = %d, want 2", queue.len())
+	}
+	writes := queue.take()
+	if keys := queueKeys(writes); len(keys) != 2 || keys[0] != "a" || keys[1] != "b" {
+		t.Fatalf("take() keys = %v, want [a b]", keys)
+	}
+	if writes[0].value != nil {
+		t.Errorf("write of a = %q, want the last one (delete)", writes[0].value)
+	}
+	if queue.len() != 0 {
+		t.Errorf("len() after take = %d, want 0", queue.len())
+	}
+}
+
+func TestRedisWriteQueueRequeue(t *testing.T) {
+	queue := newRedisWriteQueue()
+	queue.push("a", []byte("1"))
+	queue.push("b", []byte("1"))
+	failed := queue.take()
+	queue.push("c", []byte("1"))
+	queue.push("b", []byte("2"))
+	queue.requeue(failed)
+	writes := queue.take()
+	if keys := queueKeys(writes); len(keys) != 3 || keys[0] != "a" || keys[1] != "c" || keys[2] != "b" {
+		t.Fatalf("take() keys = %v, want [a c b]", keys)
+	}
+	if string(writes[2].value) != "2" {
+		t.Errorf("write of b = %q, want the one queued after the failure", writes[2].value)
+	}
+}
@@ -1,81 +1,177 @@
 package sessionsservice
 
 import (
-	"sync"
+	"strconv"
 	"time"
 
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-var sessions Sessions
-
-type Sessions struct {
-	mutex sync.Mutex
-	list  []Session
-}
-
+// Every field is mapped to the field of SessionCopy of the same name, see SessionToSessionsService
 type Session struct {
 	//Used on CDR
 	CallerUid               string
 	CalleeUid               string
 	DateStart               time.Time
+	RecordId                string
 	OriginalCallerNum       string
 	OriginalCalleeNum       string
 	CallerNum               string
 	CalleeNum               string
 	CallDirection           string
+	CallType                string
 	CallEvent               string
 	FsDirection             string
 	HangupSide              string
 	HangupReason            string
 	DateRing                time.Time
 	DateCon                 time.Time
+	DateEnd                 time.Time
+	ServiceId               string
+	CallerNickname          string
+	CalleeNickname          string
 	CallState               string
+	IvrState                string
+	RecordingName           string
 	OriginationCallerIdName string
 	OriginationCalleeIdName string
 	EffectiveCallerIdName   string
 	EffectiveCalleeIdName   string
 	OtherLegCalleeIdName    string
+	CallerType              CallerType
+	CalleeType              CallerType
+	Pole                    string
+	Node                    string
+	MonitoredUid            string
+	MonitorMode             MonitorMode
+	IsC2C                   bool
+	OriginateId             string
+	IsRecorded              string
+	CallId                  string
+	// Transfers of the session, oldest first
+	Transfers []Transfer
+	// Uid of the leg staying in the session while it is transferred
+	TransferringUid string
+	// Start of the current hold, zero when the session is not on hold
+	HoldStart time.Time
+	// Seconds spent on hold by the ended holds
+	HoldAccum int64
+	// Number of times the session was put on hold
+	HoldCount int32
+	// Custom channel variables set on the session
+	Variables map[string]string
 }
 
-func LockSessions() {
-	sessions.mutex.Lock()
+// Caller/callee type of a channel variable, given by its number ("0".."4") or its name, EXTERNAL if unknown
+func ParseCallerType(value string) CallerType {
+	if number, err := strconv.Atoi(value); err == nil {
+		if _, exist := CallerType_name[int32(number)]; exist {
+			return CallerType(number)
+		}
+	}
+	if number, exist := CallerType_value[value]; exist {
+		return CallerType(number)
+	}
+	return CallerType_EXTERNAL
 }
 
-func UnlockSessions() {
-	sessions.mutex.Unlock()
+// One transfer of a session, the party FromUid being replaced by the party ToUid
+type Transfer struct {
+	Kind    TransferKind
+	FromUid string
+	FromNum string
+	ToUid   string
+	ToNum   string
+	Date    time.Time
 }
 
-func GetSessions() *[]Session {
-	return &sessions.list
+func TransfersToTransfersCopy(transfers []Transfer) []*TransferCopy {
+	var transfersCopy []*TransferCopy
+	for _, transfer := range transfers {
+		transfersCopy = append(transfersCopy, &TransferCopy{
+			Kind:    transfer.Kind,
+			FromUid: transfer.FromUid,
+			FromNum: transfer.FromNum,
+			ToUid:   transfer.ToUid,
+			ToNum:   transfer.ToNum,
+			Date:    timestamppb.New(transfer.Date),
+		})
+	}
+	return transfersCopy
 }
 
-func SetSessions(newSessions []Session) []Session {
-	sessions.list = newSessions
-	return sessions.list
+func TransfersCopyToTransfers(transfersCopy []*TransferCopy) []Transfer {
+	var transfers []Transfer
+	for _, transferCopy := range transfersCopy {
+		transfers = append(transfers, Transfer{
+			Kind:    transferCopy.GetKind(),
+			FromUid: transferCopy.GetFromUid(),
+			FromNum: transferCopy.GetFromNum(),
+			ToUid:   transferCopy.GetToUid(),
+			ToNum:   transferCopy.GetToNum(),
+			Date:    transferCopy.GetDate().AsTime(),
+		})
+	}
+	return transfers
+}
+
+// Copy of the custom variables, nil if there is none, so that sessions never share their map
+func CopyVariables(variables map[string]string) map[string]string {
+	if len(variables) == 0 {
+		return nil
+	}
+	copied := make(map[string]string, len(variables))
+	for key, value := range variables {
+		copied[key] = value
+	}
+	return copied
 }
 
 func SessionToSessionsService(session *Session) *SessionCopy {
 	return &SessionCopy{CallerUid: session.CallerUid,
 		CalleeUid:               session.CalleeUid,
 		DateStart:               timestamppb.New(session.DateStart),
+		RecordId:                session.RecordId,
 		OriginalCallerNum:       session.OriginalCallerNum,
 		OriginalCalleeNum:       session.OriginalCalleeNum,
 		CallerNum:               session.CallerNum,
 		CalleeNum:               session.CalleeNum,
 		CallDirection:           session.CallDirection,
+		CallType:                session.CallType,
 		CallEvent:               session.CallEvent,
 		FsDirection:             session.FsDirection,
 		HangupSide:              session.HangupSide,
 		HangupReason:            session.HangupReason,
 		DateRing:                timestamppb.New(session.DateRing),
 		DateCon:                 timestamppb.New(session.DateCon),
+		DateEnd:                 timestamppb.New(session.DateEnd),
+		ServiceId:               session.ServiceId,
+		CallerNickname:          session.CallerNickname,
+		CalleeNickname:          session.CalleeNickname,
 		CallState:               session.CallState,
+		IvrState:                session.IvrState,
+		RecordingName:           session.RecordingName,
 		OriginationCallerIdName: session.OriginationCallerIdName,
 		OriginationCalleeIdName: session.OriginationCalleeIdName,
 		EffectiveCallerIdName:   session.EffectiveCallerIdName,
 		EffectiveCalleeIdName:   session.EffectiveCalleeIdName,
 		OtherLegCalleeIdName:    session.OtherLegCalleeIdName,
+		Pole:                    session.Pole,
+		CallerType:              session.CallerType,
+		CalleeType:              session.CalleeType,
+		Node:                    session.Node,
+		MonitoredUid:            session.MonitoredUid,
+		MonitorMode:             session.MonitorMode,
+		IsC2C:                   session.IsC2C,
+		OriginateId:             session.OriginateId,
+		IsRecorded:              session.IsRecorded,
+		CallId:                  session.CallId,
+		Transfers:               TransfersToTransfersCopy(session.Transfers),
+		TransferringUid:         session.TransferringUid,
+		HoldStart:               timestamppb.New(session.HoldStart),
+		HoldAccum:               session.HoldAccum,
+		HoldCount:               session.HoldCount,
+		Variables:               CopyVariables(session.Variables),
 	}
 }
 
@@ -93,148 +189,55 @@ func SessionServiceToSession(sessionCopy *SessionCopy) *Session {
 	session.CallerUid = sessionCopy.GetCallerUid()
 	session.CalleeUid = sessionCopy.GetCalleeUid()
 	session.DateStart = sessionCopy.GetDateStart().AsTime()
+	session.RecordId = sessionCopy.GetRecordId()
 	session.OriginalCallerNum = sessionCopy.GetOriginalCallerNum()
 	session.OriginalCalleeNum = sessionCopy.GetOriginalCalleeNum()
 	session.CallerNum = sessionCopy.GetCallerNum()
 	session.CalleeNum = sessionCopy.GetCalleeNum()
 	session.CallDirection = sessionCopy.GetCallDirection()
+	session.CallType = sessionCopy.GetCallType()
 	session.CallEvent = sessionCopy.GetCallEvent()
 	session.FsDirection = sessionCopy.GetFsDirection()
 	session.HangupSide = sessionCopy.GetHangupSide()
 	session.HangupReason = sessionCopy.GetHangupReason()
 	session.DateRing = sessionCopy.GetDateRing().AsTime()
 	session.DateCon = sessionCopy.GetDateCon().AsTime()
+	session.DateEnd = sessionCopy.GetDateEnd().AsTime()
+	session.ServiceId = sessionCopy.GetServiceId()
+	session.CallerNickname = sessionCopy.GetCallerNickname()
+	session.CalleeNickname = sessionCopy.GetCalleeNickname()
 	session.CallState = sessionCopy.GetCallState()
+	session.IvrState = sessionCopy.GetIvrState()
+	session.RecordingName = sessionCopy.GetRecordingName()
 	session.OriginationCallerIdName = sessionCopy.GetOriginationCallerIdName()
 	session.OriginationCalleeIdName = sessionCopy.GetOriginationCalleeIdName()
 	session.EffectiveCallerIdName = sessionCopy.GetEffectiveCallerIdName()
 	session.EffectiveCalleeIdName = sessionCopy.GetEffectiveCalleeIdName()
 	session.OtherLegCalleeIdName = sessionCopy.GetOtherLegCalleeIdName()
+	session.Pole = sessionCopy.GetPole()
+	session.CallerType = sessionCopy.GetCallerType()
+	session.CalleeType = sessionCopy.GetCalleeType()
+	session.Node = sessionCopy.GetNode()
+	session.MonitoredUid = sessionCopy.GetMonitoredUid()
+	session.MonitorMode = sessionCopy.GetMonitorMode()
+	session.IsC2C = sessionCopy.GetIsC2C()
+	session.OriginateId = sessionCopy.GetOriginateId()
+	session.IsRecorded = sessionCopy.GetIsRecorded()
+	session.CallId = sessionCopy.GetCallId()
+	session.Transfers = TransfersCopyToTransfers(sessionCopy.GetTransfers())
+	session.TransferringUid = sessionCopy.GetTransferringUid()
+	session.HoldStart = sessionCopy.GetHoldStart().AsTime()
+	session.HoldAccum = sessionCopy.GetHoldAccum()
+	session.HoldCount = sessionCopy.GetHoldCount()
+	session.Variables = CopyVariables(sessionCopy.GetVariables())
 	return &session
 }
 
+// Fresh sessions of the copies, nothing being kept by the package
 func SessionsCopyServiceToSessions(sessionsCopy *SessionsCopy) []Session {
-	sessions.list = nil
-	for _, session := range sessionsCopy.SessionCopy {
-		sessions.list = append(sessions.list, *SessionServiceToSession(session))
+	var list []Session
+	for _, session := range sessionsCopy.GetSessionCopy() {
+		list = append(list, *SessionServiceToSession(session))
 	}
-	return sessions.list
-}
-
-func GetSession(callerUid string, calleeUid string, exactly bool, onlyOneUid bool) (*Session, int, bool) {
-	if exactly {
-		for id, session := range sessions.list {
-			if session.CallerUid == callerUid && session.CalleeUid == calleeUid {
-				return &session, id, true
-			}
-		}
-		for id, session := range sessions.list {
-			if session.CallerUid == calleeUid && session.CalleeUid == callerUid {
-				return &session, id, true
-			}
-		}
-	} else {
-		if calleeUid == "" {
-			calleeUid = "not_exist"
-		}
-		if callerUid == "" {
-			callerUid = "not_exist"
-		}
-		for id, session := range sessions.list {
-			if session.CallerUid == callerUid && session.CalleeUid == calleeUid {
-				return &session, id, true
-			}
-		}
-		for id, session := range sessions.list {
-			if session.CallerUid == calleeUid && session.CalleeUid == callerUid {
-				return &session, id, true
-			}
-		}
-		for id, session := range sessions.list {
-			if session.CallerUid == callerUid && session.CalleeUid == "" {
-				return &session, id, true
-			}
-		}
-		for id, session := range sessions.list {
-			if session.CalleeUid == calleeUid && session.CallerUid == "" {
-				return &session, id, true
-			}
-		}
-		for id, session := range sessions.list {
-			if session.CalleeUid == callerUid && session.CallerUid == "" {
-				return &session, id, true
-			}
-		}
-		for id, session := range sessions.list {
-			if session.CallerUid == calleeUid && session.CalleeUid == "" {
-				return &session, id, true
-			}
-		}
-		if onlyOneUid {
-			for id, session := range sessions.list {
-				if session.CallerUid == callerUid {
-					return &session, id, true
-				}
-			}
-			for id, session := range sessions.list {
-				if session.CalleeUid == calleeUid {
-					return &session, id, true
-				}
-			}
-			for id, session := range sessions.list {
-				if session.CallerUid == calleeUid {
-					return &session, id, true
-				}
-			}
-			for id, session := range sessions.list {
-				if session.CalleeUid == callerUid {
-					return &session, id, true
-				}
-			}
-		}
-
-	}
-
-	return nil, 0, false
-}
-
-func RemoveSession(callerUid string, calleeUid string) bool {
-	for i, session := range sessions.list {
-		if session.CallerUid == callerUid && session.CalleeUid == calleeUid {
-			sessions.list = append(sessions.list[:i], sessions.list[i+1:]...)
-			return true
-		}
-	}
-	for i, session := range sessions.list {
-		if session.CallerUid == calleeUid && session.CalleeUid == callerUid {
-			sessions.list = append(sessions.list[:i], sessions.list[i+1:]...)
-			return true
-		}
-	}
-	for i, session := range sessions.list {
-		if session.CallerUid == callerUid {
-			sessions.list = append(sessions.list[:i], sessions.list[i+1:]...)
-			return true
-		}
-	}
-	for i, session := range sessions.list {
-		if session.CalleeUid == calleeUid {
-			sessions.list = append(sessions.list[:i], sessions.list[i+1:]...)
-			return true
-		}
-	}
-	for i, session := range sessions.list {
-		if session.CalleeUid == callerUid {
-			sessions.list = append(sessions.list[:i], sessions.list[i+1:]...)
-			return true
-		}
-	}
-	for i, session := range sessions.list {
-		if session.CallerUid == calleeUid {
-			sessions.list = append(sessions.list[:i], sessions.list[i+1:]...)
-			return true
-		}
-	}
-
-	return false
+	return list
 }
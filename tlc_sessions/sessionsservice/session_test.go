@@ -0,0 +1,93 @@
+package sessionsservice
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Give every field a value different from its zero value
+func fillValue(t *testing.T, value reflect.Value, name string, seed int) {
+	switch {
+	case value.Type() == reflect.TypeOf(time.Time{}):
+		value.Set(reflect.ValueOf(time.Unix(int64(1700000000+seed), int64(seed)).UTC()))
+	case value.Kind() == reflect.String:
+		value.SetString(name + "-value")
+	case value.Kind() == reflect.Bool:
+		value.SetBool(true)
+	case value.Kind() == reflect.Int32 || value.Kind() == reflect.Int64:
+		value.SetInt(1)
+	case value.Kind() == reflect.Map:
+		value.Set(reflect.ValueOf(map[string]string{name: "value"}))
+	case value.Kind() == reflect.Slice:
+		value.Set(reflect.MakeSlice(value.Type(), 1, 1))
+		fillValue(t, value.Index(0), name, seed)
+	case value.Kind() == reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			fillValue(t, value.Field(i), name+"."+value.Type().Field(i).Name, seed*100+i)
+		}
+	default:
+		t.Fatalf("field %s of kind %s is not filled by the test, add it", name, value.Kind())
+	}
+}
+
+func fillSession(t *testing.T) Session {
+	var session Session
+	fillValue(t, reflect.ValueOf(&session).Elem(), "Session", 1)
+	return session
+}
+
+// Fails whenever a field is added to Session or to SessionCopy without its counterpart and its mapping
+func TestSessionCopyRoundTrip(t *testing.T) {
+	session := fillSession(t)
+	sessionCopy := SessionToSessionsService(&session)
+
+	fields := sessionCopy.ProtoReflect().Descriptor().Fields()
+	sessionType := reflect.TypeOf(session)
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if !sessionCopy.ProtoReflect().Has(field) {
+			t.Errorf("SessionCopy.%s is not set by SessionToSessionsService", field.Name())
+		}
+		if _, found := sessionType.FieldByNameFunc(func(name string) bool { return strings.EqualFold(name, string(field.Name())) }); !found {
+			t.Errorf("SessionCopy.%s has no field in Session", field.Name())
+		}
+	}
+	for i := 0; i < sessionType.NumField(); i++ {
+		name := sessionType.Field(i).Name
+		if fields.ByName(protoreflect.Name(name)) == nil && fields.ByName(protoreflect.Name(strings.ToLower(name[:1])+name[1:])) == nil {
+			t.Errorf("Session.%s has no field in SessionCopy", name)
+		}
+	}
+
+	if back := SessionServiceToSession(sessionCopy); !reflect.DeepEqual(*back, session) {
+		t.Errorf("round trip changed the session\n got %+v\nwant %+v", *back, session)
+	}
+}
+
+func TestSessionCopyVariablesNotShared(t *testing.T) {
+	session := Session{Variables: map[string]string{"a": "1"}}
+	sessionCopy := SessionToSessionsService(&session)
+	sessionCopy.Variables["a"] = "2"
+	back := SessionServiceToSession(sessionCopy)
+	back.Variables["a"] = "3"
+	if session.Variables["a"] != "1" || sessionCopy.Variables["a"] != "2" {
+		t.Errorf("variables are shared : %v / %v", session.Variables, sessionCopy.Variables)
+	}
+}
+
+func TestSessionsCopyServiceToSessionsIsPure(t *testing.T) {
+	sessionsCopy := GetSessionsCopyService([]Session{{CallerUid: "a"}, {CallerUid: "b"}})
+	first := SessionsCopyServiceToSessions(sessionsCopy)
+	first[0].CallerUid = "changed"
+	second := SessionsCopyServiceToSessions(sessionsCopy)
+	if len(second) != 2 || second[0].CallerUid != "a" || sessionsCopy.GetSessionCopy()[0].GetCallerUid() != "a" {
+		t.Errorf("conversions share state : %+v", second)
+	}
+	if sessions := SessionsCopyServiceToSessions(nil); len(sessions) != 0 {
+		t.Errorf("nil copy = %+v", sessions)
+	}
+}
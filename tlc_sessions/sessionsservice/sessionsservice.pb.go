@@ -7,8 +7,10 @@
 package sessionsservice
 
 import (
+	duration "github.com/golang/protobuf/ptypes/duration"
 	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	wrappers "github.com/golang/protobuf/ptypes/wrappers"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -22,6 +24,376 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type MonitorMode int32
+
+const (
+	MonitorMode_LISTEN  MonitorMode = 0
+	MonitorMode_WHISPER MonitorMode = 1
+	MonitorMode_BARGE   MonitorMode = 2
+)
+
+// Enum value maps for MonitorMode.
+var (
+	MonitorMode_name = map[int32]string{
+		0: "LISTEN",
+		1: "WHISPER",
+		2: "BARGE",
+	}
+	MonitorMode_value = map[string]int32{
+		"LISTEN":  0,
+		"WHISPER": 1,
+		"BARGE":   2,
+	}
+)
+
+func (x MonitorMode) Enum() *MonitorMode {
+	p := new(MonitorMode)
+	*p = x
+	return p
+}
+
+func (x MonitorMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MonitorMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_sessionsservice_proto_enumTypes[0].Descriptor()
+}
+
+func (MonitorMode) Type() protoreflect.EnumType {
+	return &file_sessionsservice_proto_enumTypes[0]
+}
+
+func (x MonitorMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MonitorMode.Descriptor instead.
+func (MonitorMode) EnumDescriptor() ([]byte, []int) {
+	return file_sessionsservice_proto_rawDescGZIP(), []int{0}
+}
+
+type MonitorLeg int32
+
+const (
+	MonitorLeg_CALLER MonitorLeg = 0
+	MonitorLeg_CALLEE MonitorLeg = 1
+)
+
+// Enum value maps for MonitorLeg.
+var (
+	MonitorLeg_name = map[int32]string{
+		0: "CALLER",
+		1: "CALLEE",
+	}
+	MonitorLeg_value = map[string]int32{
+		"CALLER": 0,
+		"CALLEE": 1,
+	}
+)
+
+func (x MonitorLeg) Enum() *MonitorLeg {
+	p := new(MonitorLeg)
+	*p = x
+	return p
+}
+
+func (x MonitorLeg) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MonitorLeg) Descriptor() protoreflect.EnumDescriptor {
+	return file_sessionsservice_proto_enumTypes[1].Descriptor()
+}
+
+func (MonitorLeg) Type() protoreflect.EnumType {
+	return &file_sessionsservice_proto_enumTypes[1]
+}
+
+func (x MonitorLeg) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MonitorLeg.Descriptor instead.
+func (MonitorLeg) EnumDescriptor() ([]byte, []int) {
+	return file_sessionsservice_proto_rawDescGZIP(), []int{1}
+}
+
+type LegRole int32
+
+const (
+	LegRole_LEG_ORIGINATOR        LegRole = 0
+	LegRole_LEG_DESTINATION       LegRole = 1
+	LegRole_LEG_GROUP_MEMBER      LegRole = 2
+	LegRole_LEG_CONFERENCE_MEMBER LegRole = 3
+	LegRole_LEG_SUPERVISOR        LegRole = 4
+	LegRole_LEG_TRANSFER_TARGET   LegRole = 5
+)
+
+// Enum value maps for LegRole.
+var (
+	LegRole_name = map[int32]string{
+		0: "LEG_ORIGINATOR",
+		1: "LEG_DESTINATION",
+		2: "LEG_GROUP_MEMBER",
+		3: "LEG_CONFERENCE_MEMBER",
+		4: "LEG_SUPERVISOR",
+		5: "LEG_TRANSFER_TARGET",
+	}
+	LegRole_value = map[string]int32{
+		"LEG_ORIGINATOR":        0,
+		"LEG_DESTINATION":       1,
+		"LEG_GROUP_MEMBER":      2,
+		"LEG_CONFERENCE_MEMBER": 3,
+		"LEG_SUPERVISOR":        4,
+		"LEG_TRANSFER_TARGET":   5,
+	}
+)
+
+func (x LegRole) Enum() *LegRole {
+	p := new(LegRole)
+	*p = x
+	return p
+}
+
+func (x LegRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LegRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_sessionsservice_proto_enumTypes[2].Descriptor()
+}
+
+func (LegRole) Type() protoreflect.EnumType {
+	return &file_sessionsservice_proto_enumTypes[2]
+}
+
+func (x LegRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LegRole.Descriptor instead.
+func (LegRole) EnumDescriptor() ([]byte, []int) {
+	return file_sessionsservice_proto_rawDescGZIP(), []int{2}
+}
+
+type CallChange int32
+
+const (
+	CallChange_LEG_ADDED         CallChange = 0
+	CallChange_BRIDGED           CallChange = 1
+	CallChange_UNBRIDGED         CallChange = 2
+	CallChange_MERGED            CallChange = 3
+	CallChange_CONFERENCE_JOINED CallChange = 4
+	CallChange_CONFERENCE_LEFT   CallChange = 5
+	CallChange_LEG_ENDED         CallChange = 6
+	CallChange_TRANSFERRED       CallChange = 7
+)
+
+// Enum value maps for CallChange.
+var (
+	CallChange_name = map[int32]string{
+		0: "LEG_ADDED",
+		1: "BRIDGED",
+		2: "UNBRIDGED",
+		3: "MERGED",
+		4: "CONFERENCE_JOINED",
+		5: "CONFERENCE_LEFT",
+		6: "LEG_ENDED",
+		7: "TRANSFERRED",
+	}
+	CallChange_value = map[string]int32{
+		"LEG_ADDED":         0,
+		"BRIDGED":           1,
+		"UNBRIDGED":         2,
+		"MERGED":            3,
+		"CONFERENCE_JOINED": 4,
+		"CONFERENCE_LEFT":   5,
+		"LEG_ENDED":         6,
+		"TRANSFERRED":       7,
+	}
+)
+
+func (x CallChange) Enum() *CallChange {
+	p := new(CallChange)
+	*p = x
+	return p
+}
+
+func (x CallChange) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CallChange) Descriptor() protoreflect.EnumDescriptor {
+	return file_sessionsservice_proto_enumTypes[3].Descriptor()
+}
+
+func (CallChange) Type() protoreflect.EnumType {
+	return &file_sessionsservice_proto_enumTypes[3]
+}
+
+func (x CallChange) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CallChange.Descriptor instead.
+func (CallChange) EnumDescriptor() ([]byte, []int) {
+	return file_sessionsservice_proto_rawDescGZIP(), []int{3}
+}
+
+type CallerType int32
+
+const (
+	CallerType_EXTERNAL    CallerType = 0
+	CallerType_AGENT       CallerType = 1
+	CallerType_LOGGED_USER CallerType = 2
+	CallerType_EXTENSION   CallerType = 3
+	CallerType_IVR         CallerType = 4
+)
+
+// Enum value maps for CallerType.
+var (
+	CallerType_name = map[int32]string{
+		0: "EXTERNAL",
+		1: "AGENT",
+		2: "LOGGED_USER",
+		3: "EXTENSION",
+		4: "IVR",
+	}
+	CallerType_value = map[string]int32{
+		"EXTERNAL":    0,
+		"AGENT":       1,
+		"LOGGED_USER": 2,
+		"EXTENSION":   3,
+		"IVR":         4,
+	}
+)
+
+func (x CallerType) Enum() *CallerType {
+	p := new(CallerType)
+	*p = x
+	return p
+}
+
+func (x CallerType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CallerType) Descriptor() protoreflect.EnumDescriptor {
+	return file_sessionsservice_proto_enumTypes[4].Descriptor()
+}
+
+func (CallerType) Type() protoreflect.EnumType {
+	return &file_sessionsservice_proto_enumTypes[4]
+}
+
+func (x CallerType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CallerType.Descriptor instead.
+func (CallerType) EnumDescriptor() ([]byte, []int) {
+	return file_sessionsservice_proto_rawDescGZIP(), []int{4}
+}
+
+type TransferKind int32
+
+const (
+	TransferKind_BLIND    TransferKind = 0
+	TransferKind_ATTENDED TransferKind = 1
+)
+
+// Enum value maps for TransferKind.
+var (
+	TransferKind_name = map[int32]string{
+		0: "BLIND",
+		1: "ATTENDED",
+	}
+	TransferKind_value = map[string]int32{
+		"BLIND":    0,
+		"ATTENDED": 1,
+	}
+)
+
+func (x TransferKind) Enum() *TransferKind {
+	p := new(TransferKind)
+	*p = x
+	return p
+}
+
+func (x TransferKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransferKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_sessionsservice_proto_enumTypes[5].Descriptor()
+}
+
+func (TransferKind) Type() protoreflect.EnumType {
+	return &file_sessionsservice_proto_enumTypes[5]
+}
+
+func (x TransferKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransferKind.Descriptor instead.
+func (TransferKind) EnumDescriptor() ([]byte, []int) {
+	return file_sessionsservice_proto_rawDescGZIP(), []int{5}
+}
+
+type SessionChange_ChangeType int32
+
+const (
+	SessionChange_SNAPSHOT SessionChange_ChangeType = 0
+	SessionChange_CREATED  SessionChange_ChangeType = 1
+	SessionChange_UPDATED  SessionChange_ChangeType = 2
+	SessionChange_REMOVED  SessionChange_ChangeType = 3
+)
+
+// Enum value maps for SessionChange_ChangeType.
+var (
+	SessionChange_ChangeType_name = map[int32]string{
+		0: "SNAPSHOT",
+		1: "CREATED",
+		2: "UPDATED",
+		3: "REMOVED",
+	}
+	SessionChange_ChangeType_value = map[string]int32{
+		"SNAPSHOT": 0,
+		"CREATED":  1,
+		"UPDATED":  2,
+		"REMOVED":  3,
+	}
+)
+
+func (x SessionChange_ChangeType) Enum() *SessionChange_ChangeType {
+	p := new(SessionChange_ChangeType)
+	*p = x
+	return p
+}
+
+func (x SessionChange_ChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SessionChange_ChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_sessionsservice_proto_enumTypes[6].Descriptor()
+}
+
+func (SessionChange_ChangeType) Type() protoreflect.EnumType {
+	return &file_sessionsservice_proto_enumTypes[6]
+}
+
+func (x SessionChange_ChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SessionChange_ChangeType.Descriptor instead.
+func (SessionChange_ChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_sessionsservice_proto_rawDescGZIP(), []int{24, 0}
+}
+
 type Nil struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -107,19 +479,28 @@ func (x *SessionsCopy) GetSessionCopy() []*SessionCopy {
 	return nil
 }
 
-type CallerCalleeUid struct {
+// Empty filters match every session, the repeated ones match any of their values
+type ListSessionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CallerUid  string `protobuf:"bytes,1,opt,name=callerUid,proto3" json:"callerUid,omitempty"`
-	CalleeUid  string `protobuf:"bytes,2,opt,name=calleeUid,proto3" json:"calleeUid,omitempty"`
-	Exactly    bool   `protobuf:"varint,3,opt,name=exactly,proto3" json:"exactly,omitempty"`
-	OnlyOneUid bool   `protobuf:"varint,4,opt,name=onlyOneUid,proto3" json:"onlyOneUid,omitempty"`
-}
-
-func (x *CallerCalleeUid) Reset() {
-	*x = CallerCalleeUid{}
+	Pole            string                `protobuf:"bytes,1,opt,name=pole,proto3" json:"pole,omitempty"`
+	CallStates      []string              `protobuf:"bytes,2,rep,name=callStates,proto3" json:"callStates,omitempty"`
+	CallDirection   string                `protobuf:"bytes,3,opt,name=callDirection,proto3" json:"callDirection,omitempty"`
+	FsDirection     string                `protobuf:"bytes,4,opt,name=fsDirection,proto3" json:"fsDirection,omitempty"`
+	CallerNumPrefix string                `protobuf:"bytes,5,opt,name=callerNumPrefix,proto3" json:"callerNumPrefix,omitempty"`
+	CalleeNumPrefix string                `protobuf:"bytes,6,opt,name=calleeNumPrefix,proto3" json:"calleeNumPrefix,omitempty"`
+	CallerTypes     []CallerType          `protobuf:"varint,7,rep,packed,name=callerTypes,proto3,enum=sessionsservice.CallerType" json:"callerTypes,omitempty"`
+	CalleeTypes     []CallerType          `protobuf:"varint,8,rep,packed,name=calleeTypes,proto3,enum=sessionsservice.CallerType" json:"calleeTypes,omitempty"`
+	MinDuration     *duration.Duration    `protobuf:"bytes,9,opt,name=minDuration,proto3" json:"minDuration,omitempty"`
+	FieldMask       *field_mask.FieldMask `protobuf:"bytes,10,opt,name=fieldMask,proto3" json:"fieldMask,omitempty"`
+	PageSize        int32                 `protobuf:"varint,11,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken       string                `protobuf:"bytes,12,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+}
+
+func (x *ListSessionsRequest) Reset() {
+	*x = ListSessionsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sessionsservice_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -127,13 +508,13 @@ func (x *CallerCalleeUid) Reset() {
 	}
 }
 
-func (x *CallerCalleeUid) String() string {
+func (x *ListSessionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CallerCalleeUid) ProtoMessage() {}
+func (*ListSessionsRequest) ProtoMessage() {}
 
-func (x *CallerCalleeUid) ProtoReflect() protoreflect.Message {
+func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sessionsservice_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -145,137 +526,122 @@ func (x *CallerCalleeUid) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CallerCalleeUid.ProtoReflect.Descriptor instead.
-func (*CallerCalleeUid) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
 	return file_sessionsservice_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *CallerCalleeUid) GetCallerUid() string {
+func (x *ListSessionsRequest) GetPole() string {
 	if x != nil {
-		return x.CallerUid
+		return x.Pole
 	}
 	return ""
 }
 
-func (x *CallerCalleeUid) GetCalleeUid() string {
+func (x *ListSessionsRequest) GetCallStates() []string {
 	if x != nil {
-		return x.CalleeUid
+		return x.CallStates
 	}
-	return ""
+	return nil
 }
 
-func (x *CallerCalleeUid) GetExactly() bool {
+func (x *ListSessionsRequest) GetCallDirection() string {
 	if x != nil {
-		return x.Exactly
+		return x.CallDirection
 	}
-	return false
+	return ""
 }
 
-func (x *CallerCalleeUid) GetOnlyOneUid() bool {
+func (x *ListSessionsRequest) GetFsDirection() string {
 	if x != nil {
-		return x.OnlyOneUid
+		return x.FsDirection
 	}
-	return false
-}
-
-type Var struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	CallerUid   string `protobuf:"bytes,1,opt,name=callerUid,proto3" json:"callerUid,omitempty"`
-	CalleeUid   string `protobuf:"bytes,2,opt,name=calleeUid,proto3" json:"calleeUid,omitempty"`
-	NeededKey   string `protobuf:"bytes,3,opt,name=neededKey,proto3" json:"neededKey,omitempty"`
-	NeededValue string `protobuf:"bytes,4,opt,name=neededValue,proto3" json:"neededValue,omitempty"`
+	return ""
 }
 
-func (x *Var) Reset() {
-	*x = Var{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_sessionsservice_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ListSessionsRequest) GetCallerNumPrefix() string {
+	if x != nil {
+		return x.CallerNumPrefix
 	}
+	return ""
 }
 
-func (x *Var) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ListSessionsRequest) GetCalleeNumPrefix() string {
+	if x != nil {
+		return x.CalleeNumPrefix
+	}
+	return ""
 }
 
-func (*Var) ProtoMessage() {}
-
-func (x *Var) ProtoReflect() protoreflect.Message {
-	mi := &file_sessionsservice_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ListSessionsRequest) GetCallerTypes() []CallerType {
+	if x != nil {
+		return x.CallerTypes
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use Var.ProtoReflect.Descriptor instead.
-func (*Var) Descriptor() ([]byte, []int) {
-	return file_sessionsservice_proto_rawDescGZIP(), []int{3}
+func (x *ListSessionsRequest) GetCalleeTypes() []CallerType {
+	if x != nil {
+		return x.CalleeTypes
+	}
+	return nil
 }
 
-func (x *Var) GetCallerUid() string {
+func (x *ListSessionsRequest) GetMinDuration() *duration.Duration {
 	if x != nil {
-		return x.CallerUid
+		return x.MinDuration
 	}
-	return ""
+	return nil
 }
 
-func (x *Var) GetCalleeUid() string {
+func (x *ListSessionsRequest) GetFieldMask() *field_mask.FieldMask {
 	if x != nil {
-		return x.CalleeUid
+		return x.FieldMask
 	}
-	return ""
+	return nil
 }
 
-func (x *Var) GetNeededKey() string {
+func (x *ListSessionsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.NeededKey
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *Var) GetNeededValue() string {
+func (x *ListSessionsRequest) GetPageToken() string {
 	if x != nil {
-		return x.NeededValue
+		return x.PageToken
 	}
 	return ""
 }
 
-type VarMultiple struct {
+type ListSessionsReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CallerUid      string            `protobuf:"bytes,1,opt,name=callerUid,proto3" json:"callerUid,omitempty"`
-	CalleeUid      string            `protobuf:"bytes,2,opt,name=calleeUid,proto3" json:"calleeUid,omitempty"`
-	NeededKeyValue map[string]string `protobuf:"bytes,3,rep,name=neededKeyValue,proto3" json:"neededKeyValue,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	SessionCopy   []*SessionCopy `protobuf:"bytes,1,rep,name=sessionCopy,proto3" json:"sessionCopy,omitempty"`
+	NextPageToken string         `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	TotalSize     int32          `protobuf:"varint,3,opt,name=totalSize,proto3" json:"totalSize,omitempty"`
 }
 
-func (x *VarMultiple) Reset() {
-	*x = VarMultiple{}
+func (x *ListSessionsReply) Reset() {
+	*x = ListSessionsReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sessionsservice_proto_msgTypes[4]
+		mi := &file_sessionsservice_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VarMultiple) String() string {
+func (x *ListSessionsReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VarMultiple) ProtoMessage() {}
+func (*ListSessionsReply) ProtoMessage() {}
 
-func (x *VarMultiple) ProtoReflect() protoreflect.Message {
-	mi := &file_sessionsservice_proto_msgTypes[4]
+func (x *ListSessionsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_sessionsservice_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -286,79 +652,60 @@ func (x *VarMultiple) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VarMultiple.ProtoReflect.Descriptor instead.
-func (*VarMultiple) Descriptor() ([]byte, []int) {
-	return file_sessionsservice_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ListSessionsReply.ProtoReflect.Descriptor instead.
+func (*ListSessionsReply) Descriptor() ([]byte, []int) {
+	return file_sessionsservice_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *VarMultiple) GetCallerUid() string {
+func (x *ListSessionsReply) GetSessionCopy() []*SessionCopy {
 	if x != nil {
-		return x.CallerUid
+		return x.SessionCopy
 	}
-	return ""
+	return nil
 }
 
-func (x *VarMultiple) GetCalleeUid() string {
+func (x *ListSessionsReply) GetNextPageToken() string {
 	if x != nil {
-		return x.CalleeUid
+		return x.NextPageToken
 	}
 	return ""
 }
 
-func (x *VarMultiple) GetNeededKeyValue() map[string]string {
+func (x *ListSessionsReply) GetTotalSize() int32 {
 	if x != nil {
-		return x.NeededKeyValue
+		return x.TotalSize
 	}
-	return nil
+	return 0
 }
 
-type SessionCopy struct {
+type CallerCalleeUid struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CallerUid                  string               `protobuf:"bytes,1,opt,name=callerUid,proto3" json:"callerUid,omitempty"`
-	CalleeUid                  string               `protobuf:"bytes,2,opt,name=calleeUid,proto3" json:"calleeUid,omitempty"`
-	DateStart                  *timestamp.Timestamp `protobuf:"bytes,3,opt,name=dateStart,proto3" json:"dateStart,omitempty"`
-	OriginalCallerNum          string               `protobuf:"bytes,7,opt,name=originalCallerNum,proto3" json:"originalCallerNum,omitempty"`
-	OriginalCalleeNum          string               `protobuf:"bytes,8,opt,name=originalCalleeNum,proto3" json:"originalCalleeNum,omitempty"`
-	CallerNum                  string               `protobuf:"bytes,9,opt,name=callerNum,proto3" json:"callerNum,omitempty"`
-	CalleeNum                  string               `protobuf:"bytes,10,opt,name=calleeNum,proto3" json:"calleeNum,omitempty"`
-	CallerType                 string               `protobuf:"bytes,11,opt,name=callerType,proto3" json:"callerType,omitempty"`
-	CalleeType                 string               `protobuf:"bytes,12,opt,name=calleeType,proto3" json:"calleeType,omitempty"`
-	CallDirection              string               `protobuf:"bytes,15,opt,name=callDirection,proto3" json:"callDirection,omitempty"`
-	CallType                   string               `protobuf:"bytes,16,opt,name=callType,proto3" json:"callType,omitempty"`
-	CallEvent                  string               `protobuf:"bytes,19,opt,name=callEvent,proto3" json:"callEvent,omitempty"`
-	FsDirection                string               `protobuf:"bytes,20,opt,name=fsDirection,proto3" json:"fsDirection,omitempty"`
-	HangupSide                 string               `protobuf:"bytes,21,opt,name=hangupSide,proto3" json:"hangupSide,omitempty"`
-	HangupReason               string               `protobuf:"bytes,22,opt,name=hangupReason,proto3" json:"hangupReason,omitempty"`
-	DateRing                   *timestamp.Timestamp `protobuf:"bytes,23,opt,name=dateRing,proto3" json:"dateRing,omitempty"`
-	DateCon                    *timestamp.Timestamp `protobuf:"bytes,24,opt,name=dateCon,proto3" json:"dateCon,omitempty"`
-	DateEnd                    *timestamp.Timestamp `protobuf:"bytes,25,opt,name=dateEnd,proto3" json:"dateEnd,omitempty"`
-	OriginationCallerIdName    string               `protobuf:"bytes,61,opt,name=OriginationCallerIdName,proto3" json:"OriginationCallerIdName,omitempty"`
-	OriginationCalleeIdName    string               `protobuf:"bytes,62,opt,name=OriginationCalleeIdName,proto3" json:"OriginationCalleeIdName,omitempty"`
-	EffectiveCallerIdName      string               `protobuf:"bytes,63,opt,name=EffectiveCallerIdName,proto3" json:"EffectiveCallerIdName,omitempty"`
-	EffectiveCalleeIdName      string               `protobuf:"bytes,64,opt,name=EffectiveCalleeIdName,proto3" json:"EffectiveCalleeIdName,omitempty"`
-	OtherLegCalleeIdName       string               `protobuf:"bytes,65,opt,name=OtherLegCalleeIdName,proto3" json:"OtherLegCalleeIdName,omitempty"`
+	CallerUid  string `protobuf:"bytes,1,opt,name=callerUid,proto3" json:"callerUid,omitempty"`
+	CalleeUid  string `protobuf:"bytes,2,opt,name=calleeUid,proto3" json:"calleeUid,omitempty"`
+	Exactly    bool   `protobuf:"varint,3,opt,name=exactly,proto3" json:"exactly,omitempty"`
+	OnlyOneUid bool   `protobuf:"varint,4,opt,name=onlyOneUid,proto3" json:"onlyOneUid,omitempty"`
 }
 
-func (x *SessionCopy) Reset() {
-	*x = SessionCopy{}
+func (x *CallerCalleeUid) Reset() {
+	*x = CallerCalleeUid{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sessionsservice_proto_msgTypes[5]
+		mi := &file_sessionsservice_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SessionCopy) String() string {
+func (x *CallerCalleeUid) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SessionCopy) ProtoMessage() {}
+func (*CallerCalleeUid) ProtoMessage() {}
 
-func (x *SessionCopy) ProtoReflect() protoreflect.Message {
-	mi := &file_sessionsservice_proto_msgTypes[5]
+func (x *CallerCalleeUid) ProtoReflect() protoreflect.Message {
+	mi := &file_sessionsservice_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -369,410 +716,2599 @@ func (x *SessionCopy) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SessionCopy.ProtoReflect.Descriptor instead.
-func (*SessionCopy) Descriptor() ([]byte, []int) {
-	return file_sessionsservice_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use CallerCalleeUid.ProtoReflect.Descriptor instead.
+func (*CallerCalleeUid) Descriptor() ([]byte, []int) {
+	return file_sessionsservice_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *SessionCopy) GetCallerUid() string {
+func (x *CallerCalleeUid) GetCallerUid() string {
 	if x != nil {
 		return x.CallerUid
 	}
 	return ""
 }
 
-func (x *SessionCopy) GetCalleeUid() string {
+func (x *CallerCalleeUid) GetCalleeUid() string {
 	if x != nil {
 		return x.CalleeUid
 	}
 	return ""
 }
 
-func (x *SessionCopy) GetDateStart() *timestamp.Timestamp {
+func (x *CallerCalleeUid) GetExactly() bool {
 	if x != nil {
-		return x.DateStart
+		return x.Exactly
 	}
-	return nil
+	return false
 }
 
-func (x *SessionCopy) GetOriginalCallerNum() string {
+func (x *CallerCalleeUid) GetOnlyOneUid() bool {
 	if x != nil {
-		return x.OriginalCallerNum
+		return x.OnlyOneUid
 	}
-	return ""
+	return false
 }
 
-func (x *SessionCopy) GetOriginalCalleeNum() string {
-	if x != nil {
-		return x.OriginalCalleeNum
-	}
-	return ""
+type Var struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CallerUid   string `protobuf:"bytes,1,opt,name=callerUid,proto3" json:"callerUid,omitempty"`
+	CalleeUid   string `protobuf:"bytes,2,opt,name=calleeUid,proto3" json:"calleeUid,omitempty"`
+	NeededKey   string `protobuf:"bytes,3,opt,name=neededKey,proto3" json:"neededKey,omitempty"`
+	NeededValue string `protobuf:"bytes,4,opt,name=neededValue,proto3" json:"neededValue,omitempty"`
 }
 
-func (x *SessionCopy) GetCallerNum() string {
-	if x != nil {
-		return x.CallerNum
+func (x *Var) Reset() {
+	*x = Var{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sessionsservice_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *SessionCopy) GetCalleeNum() string {
-	if x != nil {
-		return x.CalleeNum
+func (x *Var) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Var) ProtoMessage() {}
+
+func (x *Var) ProtoReflect() protoreflect.Message {
+	mi := &file_sessionsservice_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Var.ProtoReflect.Descriptor instead.
+func (*Var) Descriptor() ([]byte, []int) {
+	return file_sessionsservice_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *SessionCopy) GetCallerType() string {
+func (x *Var) GetCallerUid() string {
 	if x != nil {
-		return x.CallerType
+		return x.CallerUid
 	}
 	return ""
 }
 
-func (x *SessionCopy) GetCalleeType() string {
+func (x *Var) GetCalleeUid() string {
 	if x != nil {
-		return x.CalleeType
+		return x.CalleeUid
 	}
 	return ""
 }
 
-func (x *SessionCopy) GetCallDirection() string {
+func (x *Var) GetNeededKey() string {
 	if x != nil {
-		return x.CallDirection
+		return x.NeededKey
 	}
 	return ""
 }
 
-func (x *SessionCopy) GetCallType() string {
+func (x *Var) GetNeededValue() string {
 	if x != nil {
-		return x.CallType
+		return x.NeededValue
 	}
 	return ""
 }
 
-func (x *SessionCopy) GetCallEvent() string {
-	if x != nil {
-		return x.CallEvent
+type VarMultiple struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CallerUid      string            `protobuf:"bytes,1,opt,name=callerUid,proto3" json:"callerUid,omitempty"`
+	CalleeUid      string            `protobuf:"bytes,2,opt,name=calleeUid,proto3" json:"calleeUid,omitempty"`
+	NeededKeyValue map[string]string `protobuf:"bytes,3,rep,name=neededKeyValue,proto3" json:"neededKeyValue,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *VarMultiple) Reset() {
+	*x = VarMultiple{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sessionsservice_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *SessionCopy) GetFsDirection() string {
-	if x != nil {
-		return x.FsDirection
+func (x *VarMultiple) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VarMultiple) ProtoMessage() {}
+
+func (x *VarMultiple) ProtoReflect() protoreflect.Message {
+	mi := &file_sessionsservice_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *SessionCopy) GetHangupSide() string {
+// Deprecated: Use VarMultiple.ProtoReflect.Descriptor instead.
+func (*VarMultiple) Descriptor() ([]byte, []int) {
+	return file_sessionsservice_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *VarMultiple) GetCallerUid() string {
 	if x != nil {
-		return x.HangupSide
+		return x.CallerUid
 	}
 	return ""
 }
 
-func (x *SessionCopy) GetHangupReason() string {
+func (x *VarMultiple) GetCalleeUid() string {
 	if x != nil {
-		return x.HangupReason
+		return x.CalleeUid
 	}
 	return ""
 }
 
-func (x *SessionCopy) GetDateRing() *timestamp.Timestamp {
+func (x *VarMultiple) GetNeededKeyValue() map[string]string {
 	if x != nil {
-		return x.DateRing
+		return x.NeededKeyValue
 	}
 	return nil
 }
 
-func (x *SessionCopy) GetDateCon() *timestamp.Timestamp {
+// value is kept as field 1 so that clients decoding a BoolValue still read it
+type SetVarReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value   bool         `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	Results []*UidResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *SetVarReply) Reset() {
+	*x = SetVarReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sessionsservice_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetVarReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVarReply) ProtoMessage() {}
+
+func (x *SetVarReply) ProtoReflect() protoreflect.Message {
+	mi := &file_sessionsservice_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVarReply.ProtoReflect.Descriptor instead.
+func (*SetVarReply) Descriptor() ([]byte, []int) {
+	return file_sessionsservice_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SetVarReply) GetValue() bool {
 	if x != nil {
-		return x.DateCon
+		return x.Value
 	}
-	return nil
+	return false
 }
 
-func (x *SessionCopy) GetDateEnd() *timestamp.Timestamp {
+func (x *SetVarReply) GetResults() []*UidResult {
 	if x != nil {
-		return x.DateEnd
+		return x.Results
 	}
 	return nil
 }
 
-func (x *SessionCopy) GetOriginationCallerIdName() string {
-	if x != nil {
-		return x.OriginationCallerIdName
+type UidResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid   string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Ok    bool   `protobuf:"varint,2,opt,name=ok,proto3" json:"ok,omitempty"`
+	Reply string `protobuf:"bytes,3,opt,name=reply,proto3" json:"reply,omitempty"`
+	Code  int32  `protobuf:"varint,4,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *UidResult) Reset() {
+	*x = UidResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sessionsservice_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *SessionCopy) GetOriginationCalleeIdName() string {
+func (x *UidResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UidResult) ProtoMessage() {}
+
+func (x *UidResult) ProtoReflect() protoreflect.Message {
+	mi := &file_sessionsservice_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UidResult.ProtoReflect.Descriptor instead.
+func (*UidResult) Descriptor() ([]byte, []int) {
+	return file_sessionsservice_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UidResult) GetUid() string {
 	if x != nil {
-		return x.OriginationCalleeIdName
+		return x.Uid
 	}
 	return ""
 }
 
-func (x *SessionCopy) GetEffectiveCallerIdName() string {
+func (x *UidResult) GetOk() bool {
 	if x != nil {
-		return x.EffectiveCallerIdName
+		return x.Ok
 	}
-	return ""
+	return false
 }
 
-func (x *SessionCopy) GetEffectiveCalleeIdName() string {
+func (x *UidResult) GetReply() string {
 	if x != nil {
-		return x.EffectiveCalleeIdName
+		return x.Reply
 	}
 	return ""
 }
 
-func (x *SessionCopy) GetOtherLegCalleeIdName() string {
+func (x *UidResult) GetCode() int32 {
 	if x != nil {
-		return x.OtherLegCalleeIdName
+		return x.Code
 	}
-	return ""
+	return 0
 }
 
-var File_sessionsservice_proto protoreflect.FileDescriptor
+type InvalidateNumberRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_sessionsservice_proto_rawDesc = []byte{
-	0x0a, 0x15, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70,
-	0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x05, 0x0a, 0x03, 0x6e, 0x69, 0x6c,
-	0x22, 0x4e, 0x0a, 0x0c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x70, 0x79,
-	0x12, 0x3e, 0x0a, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x70, 0x79, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43,
-	0x6f, 0x70, 0x79, 0x52, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x70, 0x79,
-	0x22, 0x87, 0x01, 0x0a, 0x0f, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x43, 0x61, 0x6c, 0x6c, 0x65,
-	0x65, 0x55, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x55, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x55,
-	0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x55, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x55, 0x69, 0x64,
-	0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x63, 0x74, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x65, 0x78, 0x61, 0x63, 0x74, 0x6c, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x6e,
-	0x6c, 0x79, 0x4f, 0x6e, 0x65, 0x55, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
-	0x6f, 0x6e, 0x6c, 0x79, 0x4f, 0x6e, 0x65, 0x55, 0x69, 0x64, 0x22, 0x81, 0x01, 0x0a, 0x03, 0x56,
-	0x61, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x55, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x55, 0x69, 0x64,
-	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x55, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x55, 0x69, 0x64, 0x12, 0x1c,
-	0x0a, 0x09, 0x6e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x6e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x0b,
-	0x6e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x6e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xe6,
-	0x01, 0x0a, 0x0b, 0x56, 0x61, 0x72, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x12, 0x1c,
-	0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x55, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x55, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09,
-	0x63, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x55, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x55, 0x69, 0x64, 0x12, 0x58, 0x0a, 0x0e, 0x6e, 0x65,
-	0x65, 0x64, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x30, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x61, 0x72, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65,
-	0x2e, 0x4e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x6e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x1a, 0x41, 0x0a, 0x13, 0x4e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x4b, 0x65,
-	0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xcb, 0x14, 0x0a, 0x0b, 0x53, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x70, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x65,
-	0x72, 0x55, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x6c, 0x6c,
-	0x65, 0x72, 0x55, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x55,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x65,
-	0x55, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1e, 0x0a,
-	0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x24, 0x0a,
-	0x0d, 0x61, 0x63, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x63, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x61,
-	0x62, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x12,
-	0x2c, 0x0a, 0x11, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x65,
-	0x72, 0x4e, 0x75, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x72, 0x69, 0x67,
-	0x69, 0x6e, 0x61, 0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x4e, 0x75, 0x6d, 0x12, 0x2c, 0x0a,
-	0x11, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x4e,
-	0x75, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
-	0x61, 0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x63,
-	0x61, 0x6c, 0x6c, 0x65, 0x72, 0x4e, 0x75, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x4e, 0x75, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6c,
-	0x6c, 0x65, 0x65, 0x4e, 0x75, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61,
-	0x6c, 0x6c, 0x65, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x6c, 0x6c, 0x65,
-	0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6c,
-	0x6c, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x6c, 0x6c, 0x65,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6c,
-	0x6c, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x65,
-	0x72, 0x49, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x49, 0x64, 0x18,
-	0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x49, 0x64, 0x12,
-	0x24, 0x0a, 0x0d, 0x63, 0x61, 0x6c, 0x6c, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x61, 0x6c, 0x6c, 0x44, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x54, 0x79, 0x70,
-	0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x18,
-	0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x73, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65,
-	0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x6d, 0x53, 0x65, 0x6e, 0x74, 0x18, 0x12, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x6d, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63,
-	0x61, 0x6c, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x63, 0x61, 0x6c, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x73, 0x44,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x66, 0x73, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x68,
-	0x61, 0x6e, 0x67, 0x75, 0x70, 0x53, 0x69, 0x64, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x68, 0x61, 0x6e, 0x67, 0x75, 0x70, 0x53, 0x69, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x68,
-	0x61, 0x6e, 0x67, 0x75, 0x70, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x16, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x68, 0x61, 0x6e, 0x67, 0x75, 0x70, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
-	0x36, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x65, 0x52, 0x69, 0x6e, 0x67, 0x18, 0x17, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x64,
-	0x61, 0x74, 0x65, 0x52, 0x69, 0x6e, 0x67, 0x12, 0x34, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x65, 0x43,
-	0x6f, 0x6e, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x12, 0x34, 0x0a,
-	0x07, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x64, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x64, 0x61, 0x74, 0x65,
-	0x45, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x1a,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1c, 0x0a,
-	0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63,
-	0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
-	0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x49, 0x64, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d,
-	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x12, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x34, 0x0a, 0x15, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d,
-	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x1f,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x4e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x70,
-	0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x20, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x4e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x65, 0x18,
-	0x21, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x50, 0x6f, 0x6c,
-	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x50, 0x6f, 0x6c, 0x65, 0x18,
-	0x22, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x50, 0x6f, 0x6c,
-	0x65, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x44, 0x65, 0x70, 0x61, 0x72,
-	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x23, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x61, 0x6c,
-	0x6c, 0x65, 0x72, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a,
-	0x10, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e,
-	0x74, 0x18, 0x24, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x44,
-	0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x14, 0x63, 0x61, 0x6c,
-	0x6c, 0x65, 0x72, 0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x18, 0x25, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x45,
-	0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x32, 0x0a,
-	0x14, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x4e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x26, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x63, 0x61, 0x6c,
-	0x6c, 0x65, 0x65, 0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x27, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x61, 0x6c, 0x6c, 0x65,
-	0x72, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x61, 0x6c,
-	0x6c, 0x65, 0x65, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x28, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0e, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x29, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x30, 0x0a,
-	0x13, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x63, 0x61, 0x6c, 0x6c,
-	0x65, 0x72, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
-	0x30, 0x0a, 0x13, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c,
-	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x63, 0x61,
-	0x6c, 0x6c, 0x65, 0x65, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x12, 0x3e, 0x0a, 0x0c, 0x61, 0x63, 0x64, 0x44, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x72,
-	0x74, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x0c, 0x61, 0x63, 0x64, 0x44, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x72,
-	0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x61, 0x63, 0x64, 0x44, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x64, 0x18,
-	0x2d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x0a, 0x61, 0x63, 0x64, 0x44, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x64, 0x12, 0x18, 0x0a,
-	0x07, 0x61, 0x63, 0x64, 0x54, 0x79, 0x70, 0x65, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x61, 0x63, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x6c, 0x6c, 0x65,
-	0x72, 0x53, 0x69, 0x74, 0x65, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6c,
-	0x6c, 0x65, 0x72, 0x53, 0x69, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x6c, 0x6c, 0x65,
-	0x65, 0x53, 0x69, 0x74, 0x65, 0x18, 0x30, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6c,
-	0x6c, 0x65, 0x65, 0x53, 0x69, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x18, 0x31, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x6c, 0x6c,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x14, 0x73, 0x61, 0x76, 0x65, 0x64, 0x50, 0x73,
-	0x79, 0x63, 0x68, 0x69, 0x63, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x32, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x14, 0x73, 0x61, 0x76, 0x65, 0x64, 0x50, 0x73, 0x79, 0x63, 0x68, 0x69,
-	0x63, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x1a, 0x73, 0x61, 0x76,
-	0x65, 0x64, 0x50, 0x73, 0x79, 0x63, 0x68, 0x69, 0x63, 0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65,
-	0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x33, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1a, 0x73,
-	0x61, 0x76, 0x65, 0x64, 0x50, 0x73, 0x79, 0x63, 0x68, 0x69, 0x63, 0x45, 0x6d, 0x70, 0x6c, 0x6f,
-	0x79, 0x65, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x6f, 0x62,
-	0x6f, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x34, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x6f,
-	0x62, 0x6f, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x76, 0x72, 0x53, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x18, 0x35, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x76, 0x72, 0x53,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x76, 0x72, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x18, 0x36, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x76, 0x72, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x64, 0x49, 0x64, 0x18, 0x37, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x61, 0x63, 0x64, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x69, 0x70, 0x54, 0x69,
-	0x6d, 0x65, 0x18, 0x38, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x69, 0x70, 0x54, 0x69, 0x6d,
-	0x65, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x74, 0x63, 0x18, 0x39, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x72, 0x74, 0x63, 0x12, 0x32, 0x0a, 0x14, 0x75, 0x73, 0x65, 0x72, 0x45, 0x78, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x18, 0x3a, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x14, 0x75, 0x73, 0x65, 0x72, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x43,
-	0x61, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x14, 0x75, 0x73, 0x65, 0x72, 0x45,
-	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x49, 0x64, 0x18,
-	0x3b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x75, 0x73, 0x65, 0x72, 0x45, 0x78, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x72,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x3c, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x38, 0x0a, 0x17, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x3d, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x17, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
-	0x61, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x17, 0x4f,
-	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x65,
-	0x49, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x3e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x4f, 0x72,
-	0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x49,
-	0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x15, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x3f,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x43,
-	0x61, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x15, 0x45,
-	0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x49, 0x64,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x40, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x45, 0x66, 0x66, 0x65,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x49, 0x64, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x32, 0x0a, 0x14, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x4c, 0x65, 0x67, 0x43, 0x61, 0x6c,
-	0x6c, 0x65, 0x65, 0x49, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x41, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x14, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x4c, 0x65, 0x67, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x49,
-	0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x6f, 0x6c, 0x65, 0x18, 0x42, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x6f, 0x6c, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x6f, 0x62,
-	0x6f, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x55, 0x72, 0x6c, 0x18, 0x43, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x6f, 0x62, 0x6f, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61,
-	0x63, 0x6b, 0x55, 0x72, 0x6c, 0x32, 0xc9, 0x02, 0x0a, 0x0f, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x59, 0x0a, 0x15, 0x47, 0x65, 0x74,
-	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x70, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x20, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x43, 0x61, 0x6c, 0x6c, 0x65,
-	0x65, 0x55, 0x69, 0x64, 0x1a, 0x1c, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
-	0x70, 0x79, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x70, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x14,
+	Num string `protobuf:"bytes,1,opt,name=num,proto3" json:"num,omitempty"`
+	All bool   `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"`
+}
+
+func (x *InvalidateNumberRequest) Reset() {
+	*x = InvalidateNumberRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sessionsservice_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvalidateNumberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidateNumberRequest) ProtoMessage() {}
+
+func (x *InvalidateNumberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sessionsservice_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidateNumberRequest.ProtoReflect.Descriptor instead.
+func (*InvalidateNumberRequest) Descriptor() ([]byte, []int) {
+	return file_sessionsservice_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *InvalidateNumberRequest) GetNum() string {
+	if x != nil {
+		return x.Num
+	}
+	return ""
+}
+
+func (x *InvalidateNumberRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+type CallControlRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+}
+
+func (x *CallControlRequest) Reset() {
+	*x = CallControlRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sessionsservice_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CallControlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallControlRequest) ProtoMessage() {}
+
+func (x *CallControlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sessionsservice_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallControlRequest.ProtoReflect.Descriptor instead.
+func (*CallControlRequest) Descriptor() ([]byte, []int) {
+	return file_sessionsservice_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CallControlRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+type HangupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid   string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Cause string `protobuf:"bytes,2,opt,name=cause,proto3" json:"cause,omitempty"`
+}
+
+func (x *HangupRequest) Reset() {
+	*x = HangupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sessionsservice_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HangupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HangupRequest) ProtoMessage() {}
+
+func (x *HangupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sessionsservice_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HangupRequest.ProtoReflect.Descriptor instead.
+func (*HangupRequest) Descriptor() ([]byte, []int) {
+	return file_sessionsservice_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *HangupRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *HangupRequest) GetCause() string {
+	if x != nil {
+		return x.Cause
+	}
+	return ""
+}
+
+type TransferRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid      string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Dest     string `protobuf:"bytes,2,opt,name=dest,proto3" json:"dest,omitempty"`
+	Dialplan string `protobuf:"bytes,3,opt,name=dialplan,proto3" json:"dialplan,omitempty"`
+	Context  string `protobuf:"bytes,4,opt,name=context,proto3" json:"context,omitempty"`
+}
+
+func (x *TransferRequest) Reset() {
+	*x = TransferRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sessionsservice_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferRequest) ProtoMessage() {}
+
+func (x *TransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sessionsservice_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferRequest.ProtoReflect.Descriptor instead.
+func (*TransferRequest) Descriptor() ([]byte, []int) {
+	return file_sessionsservice_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TransferRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *TransferRequest) GetDest() string {
+	if x != nil {
+		return x.Dest
+	}
+	return ""
+}
+
+func (x *TransferRequest) GetDialplan() string {
+	if x != nil {
+		return x.Dialplan
+	}
+	return ""
+}
+
+func (x *TransferRequest) GetContext() string {
+	if x != nil {
+		return x.Context
+	}
+	return ""
+}
+
+type RecordingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid  string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *RecordingRequest) Reset() {
+	*x = RecordingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sessionsservice_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordingRequest) ProtoMessage() {}
+
+func (x *RecordingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sessionsservice_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordingRequest.ProtoReflect.Descriptor instead.
+func (*RecordingRequest) Descriptor() ([]byte, []int) {
+	return file_sessionsservice_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RecordingRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *RecordingRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type CallControlReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reply string `protobuf:"bytes,1,opt,name=reply,proto3" json:"reply,omitempty"`
+	Node  string `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+}
+
+func (x *CallControlReply) Reset() {
+	*x = CallControlReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sessionsservice_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CallControlReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallControlReply) ProtoMessage() {}
+
+func (x *CallControlReply) ProtoReflect() protoreflect.Message {
+	mi := &file_sessionsservice_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallControlReply.ProtoReflect.Descriptor instead.
+func (*CallControlReply) Descriptor() ([]byte, []int) {
+	return file_sessionsservice_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CallControlReply) GetReply() string {
+	if x != nil {
+		return x.Reply
+	}
+	return ""
+}
+
+func (x *CallControlReply) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+type MonitorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid       string      `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Leg       MonitorLeg  `protobuf:"varint,2,opt,name=leg,proto3,enum=sessionsservice.MonitorLeg" json:"leg,omitempty"`
+	Extension string      `protobuf:"bytes,3,opt,name=extension,proto3" json:"extension,omitempty"`
+	Mode      MonitorMode `protobuf:"varint,4,opt,name=mode,proto3,enum=sessionsservice.MonitorMode" json:"mode,omitempty"`
+}
+
+func (x *MonitorRequest) Reset() {
+	*x = MonitorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sessionsservice_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MonitorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonitorRequest) ProtoMessage() {}
+
+func (x *MonitorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sessionsservice_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonitorRequest.ProtoReflect.Descriptor instead.
+func (*MonitorRequest) Descriptor() ([]byte, []int) {
+	return file_sessionsservice_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MonitorRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *MonitorRequest) GetLeg() MonitorLeg {
+	if x != nil {
+		return x.Leg
+	}
+	return MonitorLeg_CALLER
+}
+
+func (x *MonitorRequest) GetExtension() string {
+	if x != nil {
+		return x.Extension
+	}
+	return ""
+}
+
+func (x *MonitorRequest) GetMode() MonitorMode {
+	if x != nil {
+		return x.Mode
+	}
+	return MonitorMode_LISTEN
+}
+
+type MonitorReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SupervisorUid string `protobuf:"bytes,1,opt,name=supervisorUid,proto3" json:"supervisorUid,omitempty"`
+	MonitoredUid  string `protobuf:"bytes,2,opt,name=monitoredUid,proto3" json:"monitoredUid,omitempty"`
+	Node          string `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
+}
+
+func (x *MonitorReply) Reset() {
+	*x = MonitorReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sessionsservice_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MonitorReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonitorReply) ProtoMessage() {}
+
+func (x *MonitorReply) ProtoReflect() protoreflect.Message {
+	mi := &file_sessionsservice_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonitorReply.ProtoReflect.Descriptor instead.
+func (*MonitorReply) Descriptor() ([]byte, []int) {
+	return file_sessionsservice_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MonitorReply) GetSupervisorUid() string {
+	if x != nil {
+		return x.SupervisorUid
+	}
+	return ""
+}
+
+func (x *MonitorReply) GetMonitoredUid() string {
+	if x != nil {
+		return x.MonitoredUid
+	}
+	return ""
+}
+
+func (x *MonitorReply) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+type OriginateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Extension      string            `protobuf:"bytes,1,opt,name=extension,proto3" json:"extension,omitempty"`
+	Destination    string            `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	CallerIdNumber string            `protobuf:"bytes,3,opt,name=callerIdNumber,proto3" json:"callerIdNumber,omitempty"`
+	CallerIdName   string            `protobuf:"bytes,4,opt,name=callerIdName,proto3" json:"callerIdName,omitempty"`
+	Variables      map[string]string `protobuf:"bytes,5,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Pole           string            `protobuf:"bytes,6,opt,name=pole,proto3" json:"pole,omitempty"`
+	Context        string            `protobuf:"bytes,7,opt,name=context,proto3" json:"context,omitempty"`
+}
+
+func (x *OriginateRequest) Reset() {
+	*x = OriginateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sessionsservice_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OriginateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OriginateRequest) ProtoMessage() {}
+
+func (x *OriginateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sessionsservice_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OriginateRequest.ProtoReflect.Descriptor instead.
+func (*OriginateRequest) Descriptor() ([]byte, []int) {
+	return file_sessionsservice_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *OriginateRequest) GetExtension() string {
+	if x != nil {
+		return x.Extension
+	}
+	return ""
+}
+
+func (x *OriginateRequest) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *OriginateRequest) GetCallerIdNumber() string {
+	if x != nil {
+		return x.CallerIdNumber
+	}
+	return ""
+}
+
+func (x *OriginateRequest) GetCallerIdName() string {
+	if x != nil {
+		return x.CallerIdName
+	}
+	return ""
+}
+
+func (x *OriginateRequest) GetVariables() map[string]string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *OriginateRequest) GetPole() string {
+	if x != nil {
+		return x.Pole
+	}
+	return ""
+}
+
+func (x *OriginateRequest) GetContext() string {
+	if x != nil {
+		return x.Context
+	}
+	return ""
+}
+
+type OriginateReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	// Set on the channel as the variable tlc_originate_id to correlate its events, not the Job-UUID of the bgapi
+	OriginateId string `protobuf:"bytes,2,opt,name=originateId,proto3" json:"originateId,omitempty"`
+	Node        string `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
+}
+
+func (x *OriginateReply) Reset() {
+	*x = OriginateReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sessionsservice_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OriginateReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OriginateReply) ProtoMessage() {}
+
+func (x *OriginateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_sessionsservice_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OriginateReply.ProtoReflect.Descriptor instead.
+func (*OriginateReply) Descriptor() ([]byte, []int) {
+	return file_sessionsservice_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *OriginateReply) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *OriginateReply) GetOriginateId() string {
+	if x != nil {
+		return x.OriginateId
+	}
+	return ""
+}
+
+func (x *OriginateReply) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+type Leg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid        string               `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Role       LegRole              `protobuf:"varint,2,opt,name=role,proto3,enum=sessionsservice.LegRole" json:"role,omitempty"`
+	Num        string               `protobuf:"bytes,3,opt,name=num,proto3" json:"num,omitempty"`
+	Node       string               `protobuf:"bytes,4,opt,name=node,proto3" json:"node,omitempty"`
+	DateStart  *timestamp.Timestamp `protobuf:"bytes,5,opt,name=dateStart,proto3" json:"dateStart,omitempty"`
+	DateEnd    *timestamp.Timestamp `protobuf:"bytes,6,opt,name=dateEnd,proto3" json:"dateEnd,omitempty"`
+	CallState  string               `protobuf:"bytes,7,opt,name=callState,proto3" json:"callState,omitempty"`
+	BridgedUid string               `protobuf:"bytes,8,opt,name=bridgedUid,proto3" json:"bridgedUid,omitempty"`
+}
+
+func (x *Leg) Reset() {
+	*x = Leg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sessionsservice_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Leg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Leg) ProtoMessage() {}
+
+func (x *Leg) ProtoReflect() protoreflect.Message {
+	mi := &file_sessionsservice_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Leg.ProtoReflect.Descriptor instead.
+func (*Leg) Descriptor() ([]byte, []int) {
+	return file_sessionsservice_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Leg) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *Leg) GetRole() LegRole {
+	if x != nil {
+		return x.Role
+	}
+	return LegRole_LEG_ORIGINATOR
+}
+
+func (x *Leg) GetNum() string {
+	if x != nil {
+		return x.Num
+	}
+	return ""
+}
+
+func (x *Leg) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *Leg) GetDateStart() *timestamp.Timestamp {
+	if x != nil {
+		return x.DateStart
+	}
+	return nil
+}
+
+func (x *Leg) GetDateEnd() *timestamp.Timestamp {
+	if x != nil {
+		return x.DateEnd
+	}
+	return nil
+}
+
+func (x *Leg) GetCallState() string {
+	if x != nil {
+		return x.CallState
+	}
+	return ""
+}
+
+func (x *Leg) GetBridgedUid() string {
+	if x != nil {
+		return x.BridgedUid
+	}
+	return ""
+}
+
+type CallHistoryEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Change   CallChange           `protobuf:"varint,1,opt,name=change,proto3,enum=sessionsservice.CallChange" json:"change,omitempty"`
+	Date     *timestamp.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	Uid      string               `protobuf:"bytes,3,opt,name=uid,proto3" json:"uid,omitempty"`
+	OtherUid string               `protobuf:"bytes,4,opt,name=otherUid,proto3" json:"otherUid,omitempty"`
+	Detail   string               `protobuf:"bytes,5,opt,name=detail,proto3" json:"detail,omitempty"`
+}
+
+func (x *CallHistoryEntry) Reset() {
+	*x = CallHistoryEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sessionsservice_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CallHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallHistoryEntry) ProtoMessage() {}
+
+func (x *CallHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_sessionsservice_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallHistoryEntry.ProtoReflect.Descriptor instead.
+func (*CallHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_sessionsservice_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CallHistoryEntry) GetChange() CallChange {
+	if x != nil {
+		return x.Change
+	}
+	return CallChange_LEG_ADDED
+}
+
+func (x *CallHistoryEntry) GetDate() *timestamp.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *CallHistoryEntry) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *CallHistoryEntry) GetOtherUid() string {
+	if x != nil {
+		return x.OtherUid
+	}
+	return ""
+}
+
+func (x *CallHistoryEntry) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+// One logical call, its legs and what happened to them, with the flattened sessions of its legs
+type Call struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CallId   string              `protobuf:"bytes,1,opt,name=callId,proto3" json:"callId,omitempty"`
+	Legs     []*Leg              `protobuf:"bytes,2,rep,name=legs,proto3" json:"legs,omitempty"`
+	History  []*CallHistoryEntry `protobuf:"bytes,3,rep,name=history,proto3" json:"history,omitempty"`
+	Sessions []*SessionCopy      `protobuf:"bytes,4,rep,name=sessions,proto3" json:"sessions,omitempty"`
+}
+
+func (x *Call) Reset() {
+	*x = Call{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sessionsservice_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Call) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Call) ProtoMessage() {}
+
+func (x *Call) ProtoReflect() protoreflect.Message {
+	mi := &file_sessionsservice_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Call.ProtoReflect.Descriptor instead.
+func (*Call) Descriptor() ([]byte, []int) {
+	return file_sessionsservice_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Call) GetCallId() string {
+	if x != nil {
+		return x.CallId
+	}
+	return ""
+}
+
+func (x *Call) GetLegs() []*Leg {
+	if x != nil {
+		return x.Legs
+	}
+	return nil
+}
+
+func (x *Call) GetHistory() []*CallHistoryEntry {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *Call) GetSessions() []*SessionCopy {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+// The call is found by its id, or by the uid of one of its legs
+type GetCallRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid    string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	CallId string `protobuf:"bytes,2,opt,name=callId,proto3" json:"callId,omitempty"`
+}
+
+func (x *GetCallRequest) Reset() {
+	*x = GetCallRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sessionsservice_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCallRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCallRequest) ProtoMessage() {}
+
+func (x *GetCallRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sessionsservice_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCallRequest.ProtoReflect.Descriptor instead.
+func (*GetCallRequest) Descriptor() ([]byte, []int) {
+	return file_sessionsservice_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetCallRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *GetCallRequest) GetCallId() string {
+	if x != nil {
+		return x.CallId
+	}
+	return ""
+}
+
+type WatchSessionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revision uint64 `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	// Epoch of the last change received, the revisions of another epoch being answered with a snapshot
+	Epoch string `protobuf:"bytes,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (x *WatchSessionsRequest) Reset() {
+	*x = WatchSessionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sessionsservice_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchSessionsRequest) ProtoMessage() {}
+
+func (x *WatchSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sessionsservice_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchSessionsRequest.ProtoReflect.Descriptor instead.
+func (*WatchSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_sessionsservice_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *WatchSessionsRequest) GetRevision() uint64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *WatchSessionsRequest) GetEpoch() string {
+	if x != nil {
+		return x.Epoch
+	}
+	return ""
+}
+
+type SessionChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revision     uint64                   `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	ChangeType   SessionChange_ChangeType `protobuf:"varint,2,opt,name=changeType,proto3,enum=sessionsservice.SessionChange_ChangeType" json:"changeType,omitempty"`
+	ChangeReason string                   `protobuf:"bytes,3,opt,name=changeReason,proto3" json:"changeReason,omitempty"`
+	SessionCopy  *SessionCopy             `protobuf:"bytes,4,opt,name=sessionCopy,proto3" json:"sessionCopy,omitempty"`
+	Snapshot     []*SessionCopy           `protobuf:"bytes,5,rep,name=snapshot,proto3" json:"snapshot,omitempty"`
+	// Id of the tlc_sessions process numbering the revisions, changed at each start
+	Epoch string `protobuf:"bytes,6,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (x *SessionChange) Reset() {
+	*x = SessionChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sessionsservice_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionChange) ProtoMessage() {}
+
+func (x *SessionChange) ProtoReflect() protoreflect.Message {
+	mi := &file_sessionsservice_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionChange.ProtoReflect.Descriptor instead.
+func (*SessionChange) Descriptor() ([]byte, []int) {
+	return file_sessionsservice_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SessionChange) GetRevision() uint64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *SessionChange) GetChangeType() SessionChange_ChangeType {
+	if x != nil {
+		return x.ChangeType
+	}
+	return SessionChange_SNAPSHOT
+}
+
+func (x *SessionChange) GetChangeReason() string {
+	if x != nil {
+		return x.ChangeReason
+	}
+	return ""
+}
+
+func (x *SessionChange) GetSessionCopy() *SessionCopy {
+	if x != nil {
+		return x.SessionCopy
+	}
+	return nil
+}
+
+func (x *SessionChange) GetSnapshot() []*SessionCopy {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (x *SessionChange) GetEpoch() string {
+	if x != nil {
+		return x.Epoch
+	}
+	return ""
+}
+
+type FreeswitchStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConnIdx       int32                `protobuf:"varint,1,opt,name=connIdx,proto3" json:"connIdx,omitempty"`
+	Host          string               `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	Pole          string               `protobuf:"bytes,3,opt,name=pole,proto3" json:"pole,omitempty"`
+	Connected     bool                 `protobuf:"varint,4,opt,name=connected,proto3" json:"connected,omitempty"`
+	LastEventTime *timestamp.Timestamp `protobuf:"bytes,5,opt,name=lastEventTime,proto3" json:"lastEventTime,omitempty"`
+	Reconnects    uint64               `protobuf:"varint,6,opt,name=reconnects,proto3" json:"reconnects,omitempty"`
+}
+
+func (x *FreeswitchStatus) Reset() {
+	*x = FreeswitchStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sessionsservice_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FreeswitchStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreeswitchStatus) ProtoMessage() {}
+
+func (x *FreeswitchStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_sessionsservice_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreeswitchStatus.ProtoReflect.Descriptor instead.
+func (*FreeswitchStatus) Descriptor() ([]byte, []int) {
+	return file_sessionsservice_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *FreeswitchStatus) GetConnIdx() int32 {
+	if x != nil {
+		return x.ConnIdx
+	}
+	return 0
+}
+
+func (x *FreeswitchStatus) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *FreeswitchStatus) GetPole() string {
+	if x != nil {
+		return x.Pole
+	}
+	return ""
+}
+
+func (x *FreeswitchStatus) GetConnected() bool {
+	if x != nil {
+		return x.Connected
+	}
+	return false
+}
+
+func (x *FreeswitchStatus) GetLastEventTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastEventTime
+	}
+	return nil
+}
+
+func (x *FreeswitchStatus) GetReconnects() uint64 {
+	if x != nil {
+		return x.Reconnects
+	}
+	return 0
+}
+
+type ServiceStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Freeswitch       []*FreeswitchStatus `protobuf:"bytes,1,rep,name=freeswitch,proto3" json:"freeswitch,omitempty"`
+	RedisReachable   bool                `protobuf:"varint,2,opt,name=redisReachable,proto3" json:"redisReachable,omitempty"`
+	RedisError       string              `protobuf:"bytes,3,opt,name=redisError,proto3" json:"redisError,omitempty"`
+	MysqlReachable   bool                `protobuf:"varint,4,opt,name=mysqlReachable,proto3" json:"mysqlReachable,omitempty"`
+	MysqlError       string              `protobuf:"bytes,5,opt,name=mysqlError,proto3" json:"mysqlError,omitempty"`
+	SessionCount     int32               `protobuf:"varint,6,opt,name=sessionCount,proto3" json:"sessionCount,omitempty"`
+	RedisDegraded    bool                `protobuf:"varint,7,opt,name=redisDegraded,proto3" json:"redisDegraded,omitempty"`
+	RedisQueueLength int32               `protobuf:"varint,8,opt,name=redisQueueLength,proto3" json:"redisQueueLength,omitempty"`
+}
+
+func (x *ServiceStatus) Reset() {
+	*x = ServiceStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sessionsservice_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceStatus) ProtoMessage() {}
+
+func (x *ServiceStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_sessionsservice_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceStatus.ProtoReflect.Descriptor instead.
+func (*ServiceStatus) Descriptor() ([]byte, []int) {
+	return file_sessionsservice_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ServiceStatus) GetFreeswitch() []*FreeswitchStatus {
+	if x != nil {
+		return x.Freeswitch
+	}
+	return nil
+}
+
+func (x *ServiceStatus) GetRedisReachable() bool {
+	if x != nil {
+		return x.RedisReachable
+	}
+	return false
+}
+
+func (x *ServiceStatus) GetRedisError() string {
+	if x != nil {
+		return x.RedisError
+	}
+	return ""
+}
+
+func (x *ServiceStatus) GetMysqlReachable() bool {
+	if x != nil {
+		return x.MysqlReachable
+	}
+	return false
+}
+
+func (x *ServiceStatus) GetMysqlError() string {
+	if x != nil {
+		return x.MysqlError
+	}
+	return ""
+}
+
+func (x *ServiceStatus) GetSessionCount() int32 {
+	if x != nil {
+		return x.SessionCount
+	}
+	return 0
+}
+
+func (x *ServiceStatus) GetRedisDegraded() bool {
+	if x != nil {
+		return x.RedisDegraded
+	}
+	return false
+}
+
+func (x *ServiceStatus) GetRedisQueueLength() int32 {
+	if x != nil {
+		return x.RedisQueueLength
+	}
+	return 0
+}
+
+// One transfer of a session, the party fromUid being replaced by the party toUid
+type TransferCopy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind    TransferKind         `protobuf:"varint,1,opt,name=kind,proto3,enum=sessionsservice.TransferKind" json:"kind,omitempty"`
+	FromUid string               `protobuf:"bytes,2,opt,name=fromUid,proto3" json:"fromUid,omitempty"`
+	FromNum string               `protobuf:"bytes,3,opt,name=fromNum,proto3" json:"fromNum,omitempty"`
+	ToUid   string               `protobuf:"bytes,4,opt,name=toUid,proto3" json:"toUid,omitempty"`
+	ToNum   string               `protobuf:"bytes,5,opt,name=toNum,proto3" json:"toNum,omitempty"`
+	Date    *timestamp.Timestamp `protobuf:"bytes,6,opt,name=date,proto3" json:"date,omitempty"`
+}
+
+func (x *TransferCopy) Reset() {
+	*x = TransferCopy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sessionsservice_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferCopy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferCopy) ProtoMessage() {}
+
+func (x *TransferCopy) ProtoReflect() protoreflect.Message {
+	mi := &file_sessionsservice_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferCopy.ProtoReflect.Descriptor instead.
+func (*TransferCopy) Descriptor() ([]byte, []int) {
+	return file_sessionsservice_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *TransferCopy) GetKind() TransferKind {
+	if x != nil {
+		return x.Kind
+	}
+	return TransferKind_BLIND
+}
+
+func (x *TransferCopy) GetFromUid() string {
+	if x != nil {
+		return x.FromUid
+	}
+	return ""
+}
+
+func (x *TransferCopy) GetFromNum() string {
+	if x != nil {
+		return x.FromNum
+	}
+	return ""
+}
+
+func (x *TransferCopy) GetToUid() string {
+	if x != nil {
+		return x.ToUid
+	}
+	return ""
+}
+
+func (x *TransferCopy) GetToNum() string {
+	if x != nil {
+		return x.ToNum
+	}
+	return ""
+}
+
+func (x *TransferCopy) GetDate() *timestamp.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+type SessionCopy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CallerUid               string               `protobuf:"bytes,1,opt,name=callerUid,proto3" json:"callerUid,omitempty"`
+	CalleeUid               string               `protobuf:"bytes,2,opt,name=calleeUid,proto3" json:"calleeUid,omitempty"`
+	DateStart               *timestamp.Timestamp `protobuf:"bytes,3,opt,name=dateStart,proto3" json:"dateStart,omitempty"`
+	RecordId                string               `protobuf:"bytes,6,opt,name=recordId,proto3" json:"recordId,omitempty"`
+	OriginalCallerNum       string               `protobuf:"bytes,7,opt,name=originalCallerNum,proto3" json:"originalCallerNum,omitempty"`
+	OriginalCalleeNum       string               `protobuf:"bytes,8,opt,name=originalCalleeNum,proto3" json:"originalCalleeNum,omitempty"`
+	CallerNum               string               `protobuf:"bytes,9,opt,name=callerNum,proto3" json:"callerNum,omitempty"`
+	CalleeNum               string               `protobuf:"bytes,10,opt,name=calleeNum,proto3" json:"calleeNum,omitempty"`
+	CallDirection           string               `protobuf:"bytes,15,opt,name=callDirection,proto3" json:"callDirection,omitempty"`
+	CallType                string               `protobuf:"bytes,16,opt,name=callType,proto3" json:"callType,omitempty"`
+	CallEvent               string               `protobuf:"bytes,19,opt,name=callEvent,proto3" json:"callEvent,omitempty"`
+	FsDirection             string               `protobuf:"bytes,20,opt,name=fsDirection,proto3" json:"fsDirection,omitempty"`
+	HangupSide              string               `protobuf:"bytes,21,opt,name=hangupSide,proto3" json:"hangupSide,omitempty"`
+	HangupReason            string               `protobuf:"bytes,22,opt,name=hangupReason,proto3" json:"hangupReason,omitempty"`
+	DateRing                *timestamp.Timestamp `protobuf:"bytes,23,opt,name=dateRing,proto3" json:"dateRing,omitempty"`
+	DateCon                 *timestamp.Timestamp `protobuf:"bytes,24,opt,name=dateCon,proto3" json:"dateCon,omitempty"`
+	DateEnd                 *timestamp.Timestamp `protobuf:"bytes,25,opt,name=dateEnd,proto3" json:"dateEnd,omitempty"`
+	ServiceId               string               `protobuf:"bytes,29,opt,name=serviceId,proto3" json:"serviceId,omitempty"`
+	CallerNickname          string               `protobuf:"bytes,39,opt,name=callerNickname,proto3" json:"callerNickname,omitempty"`
+	CalleeNickname          string               `protobuf:"bytes,40,opt,name=calleeNickname,proto3" json:"calleeNickname,omitempty"`
+	CallState               string               `protobuf:"bytes,49,opt,name=callState,proto3" json:"callState,omitempty"`
+	IvrState                string               `protobuf:"bytes,54,opt,name=ivrState,proto3" json:"ivrState,omitempty"`
+	RecordingName           string               `protobuf:"bytes,60,opt,name=recordingName,proto3" json:"recordingName,omitempty"`
+	OriginationCallerIdName string               `protobuf:"bytes,61,opt,name=OriginationCallerIdName,proto3" json:"OriginationCallerIdName,omitempty"`
+	OriginationCalleeIdName string               `protobuf:"bytes,62,opt,name=OriginationCalleeIdName,proto3" json:"OriginationCalleeIdName,omitempty"`
+	EffectiveCallerIdName   string               `protobuf:"bytes,63,opt,name=EffectiveCallerIdName,proto3" json:"EffectiveCallerIdName,omitempty"`
+	EffectiveCalleeIdName   string               `protobuf:"bytes,64,opt,name=EffectiveCalleeIdName,proto3" json:"EffectiveCalleeIdName,omitempty"`
+	OtherLegCalleeIdName    string               `protobuf:"bytes,65,opt,name=OtherLegCalleeIdName,proto3" json:"OtherLegCalleeIdName,omitempty"`
+	Pole                    string               `protobuf:"bytes,66,opt,name=Pole,proto3" json:"Pole,omitempty"`
+	CallerType              CallerType           `protobuf:"varint,68,opt,name=callerType,proto3,enum=sessionsservice.CallerType" json:"callerType,omitempty"`
+	CalleeType              CallerType           `protobuf:"varint,69,opt,name=calleeType,proto3,enum=sessionsservice.CallerType" json:"calleeType,omitempty"`
+	Node                    string               `protobuf:"bytes,70,opt,name=node,proto3" json:"node,omitempty"`
+	MonitoredUid            string               `protobuf:"bytes,71,opt,name=monitoredUid,proto3" json:"monitoredUid,omitempty"`
+	MonitorMode             MonitorMode          `protobuf:"varint,72,opt,name=monitorMode,proto3,enum=sessionsservice.MonitorMode" json:"monitorMode,omitempty"`
+	IsC2C                   bool                 `protobuf:"varint,73,opt,name=isC2C,proto3" json:"isC2C,omitempty"`
+	OriginateId             string               `protobuf:"bytes,74,opt,name=originateId,proto3" json:"originateId,omitempty"`
+	IsRecorded              string               `protobuf:"bytes,75,opt,name=isRecorded,proto3" json:"isRecorded,omitempty"`
+	Variables               map[string]string    `protobuf:"bytes,76,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	CallId                  string               `protobuf:"bytes,77,opt,name=callId,proto3" json:"callId,omitempty"`
+	Transfers               []*TransferCopy      `protobuf:"bytes,78,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	TransferringUid         string               `protobuf:"bytes,79,opt,name=transferringUid,proto3" json:"transferringUid,omitempty"`
+	HoldStart               *timestamp.Timestamp `protobuf:"bytes,80,opt,name=holdStart,proto3" json:"holdStart,omitempty"`
+	HoldAccum               int64                `protobuf:"varint,81,opt,name=holdAccum,proto3" json:"holdAccum,omitempty"`
+	HoldCount               int32                `protobuf:"varint,82,opt,name=holdCount,proto3" json:"holdCount,omitempty"`
+}
+
+func (x *SessionCopy) Reset() {
+	*x = SessionCopy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sessionsservice_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionCopy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionCopy) ProtoMessage() {}
+
+func (x *SessionCopy) ProtoReflect() protoreflect.Message {
+	mi := &file_sessionsservice_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionCopy.ProtoReflect.Descriptor instead.
+func (*SessionCopy) Descriptor() ([]byte, []int) {
+	return file_sessionsservice_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SessionCopy) GetCallerUid() string {
+	if x != nil {
+		return x.CallerUid
+	}
+	return ""
+}
+
+func (x *SessionCopy) GetCalleeUid() string {
+	if x != nil {
+		return x.CalleeUid
+	}
+	return ""
+}
+
+func (x *SessionCopy) GetDateStart() *timestamp.Timestamp {
+	if x != nil {
+		return x.DateStart
+	}
+	return nil
+}
+
+func (x *SessionCopy) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+func (x *SessionCopy) GetOriginalCallerNum() string {
+	if x != nil {
+		return x.OriginalCallerNum
+	}
+	return ""
+}
+
+func (x *SessionCopy) GetOriginalCalleeNum() string {
+	if x != nil {
+		return x.OriginalCalleeNum
+	}
+	return ""
+}
+
+func (x *SessionCopy) GetCallerNum() string {
+	if x != nil {
+		return x.CallerNum
+	}
+	return ""
+}
+
+func (x *SessionCopy) GetCalleeNum() string {
+	if x != nil {
+		return x.CalleeNum
+	}
+	return ""
+}
+
+func (x *SessionCopy) GetCallDirection() string {
+	if x != nil {
+		return x.CallDirection
+	}
+	return ""
+}
+
+func (x *SessionCopy) GetCallType() string {
+	if x != nil {
+		return x.CallType
+	}
+	return ""
+}
+
+func (x *SessionCopy) GetCallEvent() string {
+	if x != nil {
+		return x.CallEvent
+	}
+	return ""
+}
+
+func (x *SessionCopy) GetFsDirection() string {
+	if x != nil {
+		return x.FsDirection
+	}
+	return ""
+}
+
+func (x *SessionCopy) GetHangupSide() string {
+	if x != nil {
+		return x.HangupSide
+	}
+	return ""
+}
+
+func (x *SessionCopy) GetHangupReason() string {
+	if x != nil {
+		return x.HangupReason
+	}
+	return ""
+}
+
+func (x *SessionCopy) GetDateRing() *timestamp.Timestamp {
+	if x != nil {
+		return x.DateRing
+	}
+	return nil
+}
+
+func (x *SessionCopy) GetDateCon() *timestamp.Timestamp {
+	if x != nil {
+		return x.DateCon
+	}
+	return nil
+}
+
+func (x *SessionCopy) GetDateEnd() *timestamp.Timestamp {
+	if x != nil {
+		return x.DateEnd
+	}
+	return nil
+}
+
+func (x *SessionCopy) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *SessionCopy) GetCallerNickname() string {
+	if x != nil {
+		return x.CallerNickname
+	}
+	return ""
+}
+
+func (x *SessionCopy) GetCalleeNickname() string {
+	if x != nil {
+		return x.CalleeNickname
+	}
+	return ""
+}
+
+func (x *SessionCopy) GetCallState() string {
+	if x != nil {
+		return x.CallState
+	}
+	return ""
+}
+
+func (x *SessionCopy) GetIvrState() string {
+	if x != nil {
+		return x.IvrState
+	}
+	return ""
+}
+
+func (x *SessionCopy) GetRecordingName() string {
+	if x != nil {
+		return x.RecordingName
+	}
+	return ""
+}
+
+func (x *SessionCopy) GetOriginationCallerIdName() string {
+	if x != nil {
+		return x.OriginationCallerIdName
+	}
+	return ""
+}
+
+func (x *SessionCopy) GetOriginationCalleeIdName() string {
+	if x != nil {
+		return x.OriginationCalleeIdName
+	}
+	return ""
+}
+
+func (x *SessionCopy) GetEffectiveCallerIdName() string {
+	if x != nil {
+		return x.EffectiveCallerIdName
+	}
+	return ""
+}
+
+func (x *SessionCopy) GetEffectiveCalleeIdName() string {
+	if x != nil {
+		return x.EffectiveCalleeIdName
+	}
+	return ""
+}
+
+func (x *SessionCopy) GetOtherLegCalleeIdName() string {
+	if x != nil {
+		return x.OtherLegCalleeIdName
+	}
+	return ""
+}
+
+func (x *SessionCopy) GetPole() string {
+	if x != nil {
+		return x.Pole
+	}
+	return ""
+}
+
+func (x *SessionCopy) GetCallerType() CallerType {
+	if x != nil {
+		return x.CallerType
+	}
+	return CallerType_EXTERNAL
+}
+
+func (x *SessionCopy) GetCalleeType() CallerType {
+	if x != nil {
+		return x.CalleeType
+	}
+	return CallerType_EXTERNAL
+}
+
+func (x *SessionCopy) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *SessionCopy) GetMonitoredUid() string {
+	if x != nil {
+		return x.MonitoredUid
+	}
+	return ""
+}
+
+func (x *SessionCopy) GetMonitorMode() MonitorMode {
+	if x != nil {
+		return x.MonitorMode
+	}
+	return MonitorMode_LISTEN
+}
+
+func (x *SessionCopy) GetIsC2C() bool {
+	if x != nil {
+		return x.IsC2C
+	}
+	return false
+}
+
+func (x *SessionCopy) GetOriginateId() string {
+	if x != nil {
+		return x.OriginateId
+	}
+	return ""
+}
+
+func (x *SessionCopy) GetIsRecorded() string {
+	if x != nil {
+		return x.IsRecorded
+	}
+	return ""
+}
+
+func (x *SessionCopy) GetVariables() map[string]string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *SessionCopy) GetCallId() string {
+	if x != nil {
+		return x.CallId
+	}
+	return ""
+}
+
+func (x *SessionCopy) GetTransfers() []*TransferCopy {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
+func (x *SessionCopy) GetTransferringUid() string {
+	if x != nil {
+		return x.TransferringUid
+	}
+	return ""
+}
+
+func (x *SessionCopy) GetHoldStart() *timestamp.Timestamp {
+	if x != nil {
+		return x.HoldStart
+	}
+	return nil
+}
+
+func (x *SessionCopy) GetHoldAccum() int64 {
+	if x != nil {
+		return x.HoldAccum
+	}
+	return 0
+}
+
+func (x *SessionCopy) GetHoldCount() int32 {
+	if x != nil {
+		return x.HoldCount
+	}
+	return 0
+}
+
+var File_sessionsservice_proto protoreflect.FileDescriptor
+
+var file_sessionsservice_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70,
+	0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x05, 0x0a, 0x03, 0x6e,
+	0x69, 0x6c, 0x22, 0x4e, 0x0a, 0x0c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f,
+	0x70, 0x79, 0x12, 0x3e, 0x0a, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x70,
+	0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x70, 0x79, 0x52, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x70, 0x79, 0x22, 0x94, 0x04, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f,
+	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x6c, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x61, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x24,
+	0x0a, 0x0d, 0x63, 0x61, 0x6c, 0x6c, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x61, 0x6c, 0x6c, 0x44, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x73, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x73, 0x44, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72,
+	0x4e, 0x75, 0x6d, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x4e, 0x75, 0x6d, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x12, 0x28, 0x0a, 0x0f, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x4e, 0x75, 0x6d, 0x50, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x61, 0x6c, 0x6c, 0x65,
+	0x65, 0x4e, 0x75, 0x6d, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x3d, 0x0a, 0x0b, 0x63, 0x61,
+	0x6c, 0x6c, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0e, 0x32,
+	0x1b, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x63, 0x61,
+	0x6c, 0x6c, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x3d, 0x0a, 0x0b, 0x63, 0x61, 0x6c,
+	0x6c, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1b,
 	0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x6e, 0x69, 0x6c, 0x1a, 0x1d, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x43,
-	0x6f, 0x70, 0x79, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x06, 0x53, 0x65, 0x74, 0x56, 0x61, 0x72, 0x12,
-	0x14, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x56, 0x61, 0x72, 0x1a, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x56, 0x61, 0x72, 0x4d, 0x75, 0x6c,
-	0x74, 0x69, 0x70, 0x6c, 0x65, 0x12, 0x1c, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x63, 0x61, 0x6c,
+	0x6c, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61,
+	0x73, 0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x4d, 0x61, 0x73, 0x6b, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x97, 0x01, 0x0a, 0x11, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x3e, 0x0a, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x70, 0x79, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x70, 0x79, 0x52, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x70, 0x79, 0x12,
+	0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69,
+	0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53,
+	0x69, 0x7a, 0x65, 0x22, 0x87, 0x01, 0x0a, 0x0f, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x43, 0x61,
+	0x6c, 0x6c, 0x65, 0x65, 0x55, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x65,
+	0x72, 0x55, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x6c, 0x6c,
+	0x65, 0x72, 0x55, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x55,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x65,
+	0x55, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x63, 0x74, 0x6c, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x78, 0x61, 0x63, 0x74, 0x6c, 0x79, 0x12, 0x1e, 0x0a,
+	0x0a, 0x6f, 0x6e, 0x6c, 0x79, 0x4f, 0x6e, 0x65, 0x55, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x6f, 0x6e, 0x6c, 0x79, 0x4f, 0x6e, 0x65, 0x55, 0x69, 0x64, 0x22, 0x81, 0x01,
+	0x0a, 0x03, 0x56, 0x61, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x55,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72,
+	0x55, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x55, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x55, 0x69,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x12,
+	0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0xe6, 0x01, 0x0a, 0x0b, 0x56, 0x61, 0x72, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x55, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x55, 0x69, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x55, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x55, 0x69, 0x64, 0x12, 0x58, 0x0a,
+	0x0e, 0x6e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
 	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x61, 0x72, 0x4d, 0x75, 0x6c, 0x74, 0x69,
-	0x70, 0x6c, 0x65, 0x1a, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x70, 0x6c, 0x65, 0x2e, 0x4e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x6e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x4b,
+	0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x41, 0x0a, 0x13, 0x4e, 0x65, 0x65, 0x64, 0x65,
+	0x64, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x59, 0x0a, 0x0b, 0x53, 0x65,
+	0x74, 0x56, 0x61, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x34, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x55, 0x69, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x57, 0x0a, 0x09, 0x55, 0x69, 0x64, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x69, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x02, 0x6f, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x3d,
+	0x0a, 0x17, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x75, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6e, 0x75, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x61,
+	0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x22, 0x26, 0x0a,
+	0x12, 0x43, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x37, 0x0a, 0x0d, 0x48, 0x61, 0x6e, 0x67, 0x75, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x61, 0x75, 0x73,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x61, 0x75, 0x73, 0x65, 0x22, 0x6d,
+	0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x64, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x61, 0x6c, 0x70,
+	0x6c, 0x61, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x61, 0x6c, 0x70,
+	0x6c, 0x61, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x38, 0x0a,
+	0x10, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x3c, 0x0a, 0x10, 0x43, 0x61, 0x6c, 0x6c, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x72,
+	0x65, 0x70, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0xa1, 0x01, 0x0a, 0x0e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x2d, 0x0a, 0x03, 0x6c, 0x65,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
+	0x72, 0x4c, 0x65, 0x67, 0x52, 0x03, 0x6c, 0x65, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x4d,
+	0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x6c, 0x0a, 0x0c, 0x4d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x75, 0x70,
+	0x65, 0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x55, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x73, 0x75, 0x70, 0x65, 0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x55, 0x69, 0x64, 0x12,
+	0x22, 0x0a, 0x0c, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x55, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x65, 0x64,
+	0x55, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0xda, 0x02, 0x0a, 0x10, 0x4f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e,
+	0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x64,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x61, 0x6c, 0x6c,
+	0x65, 0x72, 0x49, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4e, 0x0a, 0x09, 0x76, 0x61, 0x72, 0x69,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56,
+	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x76,
+	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x6c, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x1a, 0x3c, 0x0a, 0x0e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x58, 0x0a, 0x0e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0x99,
+	0x02, 0x0a, 0x03, 0x4c, 0x65, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x65, 0x67, 0x52, 0x6f, 0x6c, 0x65,
+	0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6e, 0x75, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x38, 0x0a, 0x09,
+	0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x34, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x07, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x63, 0x61, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x61, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x64, 0x55, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x64, 0x55, 0x69, 0x64, 0x22, 0xbd, 0x01, 0x0a, 0x10, 0x43,
+	0x61, 0x6c, 0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x33, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1b, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x06, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x55,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x55,
+	0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0xbf, 0x01, 0x0a, 0x04, 0x43,
+	0x61, 0x6c, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x04, 0x6c,
+	0x65, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x65, 0x67, 0x52,
+	0x04, 0x6c, 0x65, 0x67, 0x73, 0x12, 0x3b, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x12, 0x38, 0x0a, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x70, 0x79, 0x52, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x3a, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x49, 0x64, 0x22, 0x48, 0x0a, 0x14, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x22, 0xed, 0x02, 0x0a, 0x0d, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x49, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x3e, 0x0a, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x70, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x70, 0x79, 0x52, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x70, 0x79, 0x12,
+	0x38, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x70, 0x79, 0x52,
+	0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x22,
+	0x41, 0x0a, 0x0a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0c, 0x0a,
+	0x08, 0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x43,
+	0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x50, 0x44, 0x41,
+	0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44,
+	0x10, 0x03, 0x22, 0xd4, 0x01, 0x0a, 0x10, 0x46, 0x72, 0x65, 0x65, 0x73, 0x77, 0x69, 0x74, 0x63,
+	0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x6e, 0x49,
+	0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x6e, 0x49, 0x64,
+	0x78, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x40, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72,
+	0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x22, 0xd8, 0x02, 0x0a, 0x0d, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x41, 0x0a, 0x0a, 0x66,
+	0x72, 0x65, 0x65, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x46, 0x72, 0x65, 0x65, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x0a, 0x66, 0x72, 0x65, 0x65, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x26,
+	0x0a, 0x0e, 0x72, 0x65, 0x64, 0x69, 0x73, 0x52, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x72, 0x65, 0x64, 0x69, 0x73, 0x52, 0x65, 0x61,
+	0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x64, 0x69, 0x73, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x64, 0x69,
+	0x73, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x6d, 0x79, 0x73, 0x71, 0x6c, 0x52,
+	0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x6d, 0x79, 0x73, 0x71, 0x6c, 0x52, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x6d, 0x79, 0x73, 0x71, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x6d, 0x79, 0x73, 0x71, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22,
+	0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x64, 0x69, 0x73, 0x44, 0x65, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x72, 0x65, 0x64, 0x69, 0x73,
+	0x44, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x64, 0x69,
+	0x73, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x10, 0x72, 0x65, 0x64, 0x69, 0x73, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x22, 0xd1, 0x01, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x43, 0x6f, 0x70, 0x79, 0x12, 0x31, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4b, 0x69,
+	0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x72, 0x6f, 0x6d,
+	0x55, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x72, 0x6f, 0x6d, 0x55,
+	0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x72, 0x6f, 0x6d, 0x4e, 0x75, 0x6d, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x72, 0x6f, 0x6d, 0x4e, 0x75, 0x6d, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x55, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x55,
+	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x4e, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x4e, 0x75, 0x6d, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0xeb, 0x0e, 0x0a, 0x0b, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x70, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c,
+	0x65, 0x72, 0x55, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x6c,
+	0x6c, 0x65, 0x72, 0x55, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x65,
+	0x55, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x65,
+	0x65, 0x55, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x4e, 0x75, 0x6d, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x43,
+	0x61, 0x6c, 0x6c, 0x65, 0x72, 0x4e, 0x75, 0x6d, 0x12, 0x2c, 0x0a, 0x11, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x61, 0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x4e, 0x75, 0x6d, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x43, 0x61, 0x6c,
+	0x6c, 0x65, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72,
+	0x4e, 0x75, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x65,
+	0x72, 0x4e, 0x75, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x4e, 0x75,
+	0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x4e,
+	0x75, 0x6d, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x61, 0x6c, 0x6c, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x61, 0x6c, 0x6c, 0x44,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x73, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x73, 0x44, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x61, 0x6e, 0x67, 0x75, 0x70, 0x53, 0x69,
+	0x64, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x61, 0x6e, 0x67, 0x75, 0x70,
+	0x53, 0x69, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x68, 0x61, 0x6e, 0x67, 0x75, 0x70, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x68, 0x61, 0x6e, 0x67,
+	0x75, 0x70, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x69, 0x6e, 0x67, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x64, 0x61, 0x74, 0x65, 0x52, 0x69, 0x6e, 0x67,
+	0x12, 0x34, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x18, 0x18, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e,
+	0x64, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x07, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x61,
+	0x6c, 0x6c, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x27, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x4e, 0x69, 0x63, 0x6b,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x61, 0x6c, 0x6c,
+	0x65, 0x65, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61,
+	0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x31, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x61, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x76, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x36, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x76, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e,
+	0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x17, 0x4f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x49,
+	0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x3d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x4f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x64,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x17, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x49, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x3e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x49, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x34,
+	0x0a, 0x15, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x65,
+	0x72, 0x49, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x3f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x45,
+	0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x64,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x15, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x49, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x40, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x15, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x43, 0x61,
+	0x6c, 0x6c, 0x65, 0x65, 0x49, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x14, 0x4f, 0x74,
+	0x68, 0x65, 0x72, 0x4c, 0x65, 0x67, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x49, 0x64, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x41, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x4c,
+	0x65, 0x67, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x49, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x50, 0x6f, 0x6c, 0x65, 0x18, 0x42, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x6f,
+	0x6c, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x44, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x0a, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x3b, 0x0a, 0x0a, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x45, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x0a, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x6f, 0x64, 0x65, 0x18, 0x46, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65,
+	0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x55, 0x69, 0x64,
+	0x18, 0x47, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x65,
+	0x64, 0x55, 0x69, 0x64, 0x12, 0x3e, 0x0a, 0x0b, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x4d,
+	0x6f, 0x64, 0x65, 0x18, 0x48, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x69,
+	0x74, 0x6f, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0b, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72,
+	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x73, 0x43, 0x32, 0x43, 0x18, 0x49, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x43, 0x32, 0x43, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x49, 0x64, 0x18, 0x4a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
+	0x69, 0x73, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x18, 0x4b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x69, 0x73, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x12, 0x49, 0x0a, 0x09,
+	0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x4c, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2b, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x70, 0x79, 0x2e, 0x56, 0x61,
+	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x76, 0x61,
+	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x49,
+	0x64, 0x18, 0x4d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x49, 0x64, 0x12,
+	0x3b, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x4e, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x43, 0x6f, 0x70,
+	0x79, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0x28, 0x0a, 0x0f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x69, 0x64, 0x18,
+	0x4f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72,
+	0x69, 0x6e, 0x67, 0x55, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x68, 0x6f, 0x6c, 0x64, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x18, 0x50, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x68, 0x6f, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x68, 0x6f, 0x6c, 0x64, 0x41, 0x63, 0x63, 0x75, 0x6d, 0x18, 0x51, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x68, 0x6f, 0x6c, 0x64, 0x41, 0x63, 0x63, 0x75, 0x6d, 0x12, 0x1c,
+	0x0a, 0x09, 0x68, 0x6f, 0x6c, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x52, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x68, 0x6f, 0x6c, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x3c, 0x0a, 0x0e,
+	0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x4a, 0x04, 0x08, 0x0b, 0x10, 0x0c,
+	0x4a, 0x04, 0x08, 0x0c, 0x10, 0x0d, 0x2a, 0x31, 0x0a, 0x0b, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
+	0x72, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x49, 0x53, 0x54, 0x45, 0x4e, 0x10,
+	0x00, 0x12, 0x0b, 0x0a, 0x07, 0x57, 0x48, 0x49, 0x53, 0x50, 0x45, 0x52, 0x10, 0x01, 0x12, 0x09,
+	0x0a, 0x05, 0x42, 0x41, 0x52, 0x47, 0x45, 0x10, 0x02, 0x2a, 0x24, 0x0a, 0x0a, 0x4d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x4c, 0x65, 0x67, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x41, 0x4c, 0x4c, 0x45,
+	0x52, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x41, 0x4c, 0x4c, 0x45, 0x45, 0x10, 0x01, 0x2a,
+	0x90, 0x01, 0x0a, 0x07, 0x4c, 0x65, 0x67, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x4c,
+	0x45, 0x47, 0x5f, 0x4f, 0x52, 0x49, 0x47, 0x49, 0x4e, 0x41, 0x54, 0x4f, 0x52, 0x10, 0x00, 0x12,
+	0x13, 0x0a, 0x0f, 0x4c, 0x45, 0x47, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x49, 0x4e, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x4c, 0x45, 0x47, 0x5f, 0x47, 0x52, 0x4f, 0x55,
+	0x50, 0x5f, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x4c, 0x45,
+	0x47, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x45, 0x52, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x4d, 0x45, 0x4d,
+	0x42, 0x45, 0x52, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x45, 0x47, 0x5f, 0x53, 0x55, 0x50,
+	0x45, 0x52, 0x56, 0x49, 0x53, 0x4f, 0x52, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x45, 0x47,
+	0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54,
+	0x10, 0x05, 0x2a, 0x8f, 0x01, 0x0a, 0x0a, 0x43, 0x61, 0x6c, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x45, 0x47, 0x5f, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x0b, 0x0a, 0x07, 0x42, 0x52, 0x49, 0x44, 0x47, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a,
+	0x09, 0x55, 0x4e, 0x42, 0x52, 0x49, 0x44, 0x47, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06,
+	0x4d, 0x45, 0x52, 0x47, 0x45, 0x44, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x4f, 0x4e, 0x46,
+	0x45, 0x52, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x4a, 0x4f, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x04, 0x12,
+	0x13, 0x0a, 0x0f, 0x43, 0x4f, 0x4e, 0x46, 0x45, 0x52, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x4c, 0x45,
+	0x46, 0x54, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x45, 0x47, 0x5f, 0x45, 0x4e, 0x44, 0x45,
+	0x44, 0x10, 0x06, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x52,
+	0x45, 0x44, 0x10, 0x07, 0x2a, 0x4e, 0x0a, 0x0a, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x58, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x10, 0x00,
+	0x12, 0x09, 0x0a, 0x05, 0x41, 0x47, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4c,
+	0x4f, 0x47, 0x47, 0x45, 0x44, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09,
+	0x45, 0x58, 0x54, 0x45, 0x4e, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x49,
+	0x56, 0x52, 0x10, 0x04, 0x2a, 0x27, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x4b, 0x69, 0x6e, 0x64, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x4c, 0x49, 0x4e, 0x44, 0x10, 0x00, 0x12,
+	0x0c, 0x0a, 0x08, 0x41, 0x54, 0x54, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x01, 0x32, 0xd5, 0x0b,
+	0x0a, 0x0f, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x59, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x70, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x20, 0x2e, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x61, 0x6c,
+	0x6c, 0x65, 0x72, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x55, 0x69, 0x64, 0x1a, 0x1c, 0x2e, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x70, 0x79, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x16,
+	0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x70, 0x79, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x14, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6e, 0x69, 0x6c, 0x1a, 0x1d, 0x2e, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x70, 0x79, 0x22, 0x00, 0x12, 0x5a, 0x0a,
+	0x0c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x2e,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x06, 0x53, 0x65, 0x74,
+	0x56, 0x61, 0x72, 0x12, 0x14, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x61, 0x72, 0x1a, 0x1c, 0x2e, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x56,
+	0x61, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0e, 0x53, 0x65, 0x74,
+	0x56, 0x61, 0x72, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x12, 0x1c, 0x2e, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x61,
+	0x72, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x1a, 0x1c, 0x2e, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x56,
+	0x61, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0d, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x2e, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x5a, 0x0a, 0x10, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x28, 0x2e, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22,
-	0x00, 0x42, 0x4d, 0x0a, 0x20, 0x69, 0x6f, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x65, 0x78, 0x61,
-	0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x42, 0x14, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x11, 0x2e,
-	0x2f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x00, 0x12, 0x40, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x2e, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6e, 0x69,
+	0x6c, 0x1a, 0x1e, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x06, 0x48, 0x61, 0x6e, 0x67, 0x75, 0x70, 0x12, 0x1e, 0x2e,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x48, 0x61, 0x6e, 0x67, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x43, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x12, 0x51, 0x0a, 0x08, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x20,
+	0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x04, 0x48, 0x6f, 0x6c, 0x64, 0x12, 0x23, 0x2e,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x43, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x06, 0x55, 0x6e, 0x68, 0x6f, 0x6c,
+	0x64, 0x12, 0x23, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0e, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x2e,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0d, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x61, 0x6c, 0x6c,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x50,
+	0x0a, 0x04, 0x50, 0x61, 0x72, 0x6b, 0x12, 0x23, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x61,
+	0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
+	0x12, 0x4b, 0x0a, 0x07, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x1f, 0x2e, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x51, 0x0a,
+	0x09, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x12, 0x21, 0x2e, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
+	0x12, 0x43, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x1f, 0x2e, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43,
+	0x61, 0x6c, 0x6c, 0x22, 0x00, 0x42, 0x4d, 0x0a, 0x20, 0x69, 0x6f, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x42, 0x14, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x11, 0x2e, 0x2f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -787,40 +3323,131 @@ func file_sessionsservice_proto_rawDescGZIP() []byte {
 	return file_sessionsservice_proto_rawDescData
 }
 
-var file_sessionsservice_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_sessionsservice_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_sessionsservice_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_sessionsservice_proto_goTypes = []interface{}{
-	(*Nil)(nil),                 // 0: sessionsservice.nil
-	(*SessionsCopy)(nil),        // 1: sessionsservice.SessionsCopy
-	(*CallerCalleeUid)(nil),     // 2: sessionsservice.CallerCalleeUid
-	(*Var)(nil),                 // 3: sessionsservice.Var
-	(*VarMultiple)(nil),         // 4: sessionsservice.VarMultiple
-	(*SessionCopy)(nil),         // 5: sessionsservice.SessionCopy
-	nil,                         // 6: sessionsservice.VarMultiple.NeededKeyValueEntry
-	(*timestamp.Timestamp)(nil), // 7: google.protobuf.Timestamp
-	(*wrappers.BoolValue)(nil),  // 8: google.protobuf.BoolValue
+	(MonitorMode)(0),                // 0: sessionsservice.MonitorMode
+	(MonitorLeg)(0),                 // 1: sessionsservice.MonitorLeg
+	(LegRole)(0),                    // 2: sessionsservice.LegRole
+	(CallChange)(0),                 // 3: sessionsservice.CallChange
+	(CallerType)(0),                 // 4: sessionsservice.CallerType
+	(TransferKind)(0),               // 5: sessionsservice.TransferKind
+	(SessionChange_ChangeType)(0),   // 6: sessionsservice.SessionChange.ChangeType
+	(*Nil)(nil),                     // 7: sessionsservice.nil
+	(*SessionsCopy)(nil),            // 8: sessionsservice.SessionsCopy
+	(*ListSessionsRequest)(nil),     // 9: sessionsservice.ListSessionsRequest
+	(*ListSessionsReply)(nil),       // 10: sessionsservice.ListSessionsReply
+	(*CallerCalleeUid)(nil),         // 11: sessionsservice.CallerCalleeUid
+	(*Var)(nil),                     // 12: sessionsservice.Var
+	(*VarMultiple)(nil),             // 13: sessionsservice.VarMultiple
+	(*SetVarReply)(nil),             // 14: sessionsservice.SetVarReply
+	(*UidResult)(nil),               // 15: sessionsservice.UidResult
+	(*InvalidateNumberRequest)(nil), // 16: sessionsservice.InvalidateNumberRequest
+	(*CallControlRequest)(nil),      // 17: sessionsservice.CallControlRequest
+	(*HangupRequest)(nil),           // 18: sessionsservice.HangupRequest
+	(*TransferRequest)(nil),         // 19: sessionsservice.TransferRequest
+	(*RecordingRequest)(nil),        // 20: sessionsservice.RecordingRequest
+	(*CallControlReply)(nil),        // 21: sessionsservice.CallControlReply
+	(*MonitorRequest)(nil),          // 22: sessionsservice.MonitorRequest
+	(*MonitorReply)(nil),            // 23: sessionsservice.MonitorReply
+	(*OriginateRequest)(nil),        // 24: sessionsservice.OriginateRequest
+	(*OriginateReply)(nil),          // 25: sessionsservice.OriginateReply
+	(*Leg)(nil),                     // 26: sessionsservice.Leg
+	(*CallHistoryEntry)(nil),        // 27: sessionsservice.CallHistoryEntry
+	(*Call)(nil),                    // 28: sessionsservice.Call
+	(*GetCallRequest)(nil),          // 29: sessionsservice.GetCallRequest
+	(*WatchSessionsRequest)(nil),    // 30: sessionsservice.WatchSessionsRequest
+	(*SessionChange)(nil),           // 31: sessionsservice.SessionChange
+	(*FreeswitchStatus)(nil),        // 32: sessionsservice.FreeswitchStatus
+	(*ServiceStatus)(nil),           // 33: sessionsservice.ServiceStatus
+	(*TransferCopy)(nil),            // 34: sessionsservice.TransferCopy
+	(*SessionCopy)(nil),             // 35: sessionsservice.SessionCopy
+	nil,                             // 36: sessionsservice.VarMultiple.NeededKeyValueEntry
+	nil,                             // 37: sessionsservice.OriginateRequest.VariablesEntry
+	nil,                             // 38: sessionsservice.SessionCopy.VariablesEntry
+	(*duration.Duration)(nil),       // 39: google.protobuf.Duration
+	(*field_mask.FieldMask)(nil),    // 40: google.protobuf.FieldMask
+	(*timestamp.Timestamp)(nil),     // 41: google.protobuf.Timestamp
+	(*wrappers.BoolValue)(nil),      // 42: google.protobuf.BoolValue
 }
 var file_sessionsservice_proto_depIdxs = []int32{
-	5,  // 0: sessionsservice.SessionsCopy.sessionCopy:type_name -> sessionsservice.SessionCopy
-	6,  // 1: sessionsservice.VarMultiple.neededKeyValue:type_name -> sessionsservice.VarMultiple.NeededKeyValueEntry
-	7,  // 2: sessionsservice.SessionCopy.dateStart:type_name -> google.protobuf.Timestamp
-	7,  // 3: sessionsservice.SessionCopy.dateRing:type_name -> google.protobuf.Timestamp
-	7,  // 4: sessionsservice.SessionCopy.dateCon:type_name -> google.protobuf.Timestamp
-	7,  // 5: sessionsservice.SessionCopy.dateEnd:type_name -> google.protobuf.Timestamp
-	7,  // 6: sessionsservice.SessionCopy.acdDateStart:type_name -> google.protobuf.Timestamp
-	7,  // 7: sessionsservice.SessionCopy.acdDateEnd:type_name -> google.protobuf.Timestamp
-	2,  // 8: sessionsservice.SessionsService.GetSessionCopyService:input_type -> sessionsservice.CallerCalleeUid
-	0,  // 9: sessionsservice.SessionsService.GetSessionsCopyService:input_type -> sessionsservice.nil
-	3,  // 10: sessionsservice.SessionsService.SetVar:input_type -> sessionsservice.Var
-	4,  // 11: sessionsservice.SessionsService.SetVarMultiple:input_type -> sessionsservice.VarMultiple
-	5,  // 12: sessionsservice.SessionsService.GetSessionCopyService:output_type -> sessionsservice.SessionCopy
-	1,  // 13: sessionsservice.SessionsService.GetSessionsCopyService:output_type -> sessionsservice.SessionsCopy
-	8,  // 14: sessionsservice.SessionsService.SetVar:output_type -> google.protobuf.BoolValue
-	8,  // 15: sessionsservice.SessionsService.SetVarMultiple:output_type -> google.protobuf.BoolValue
-	12, // [12:16] is the sub-list for method output_type
-	8,  // [8:12] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	35, // 0: sessionsservice.SessionsCopy.sessionCopy:type_name -> sessionsservice.SessionCopy
+	4,  // 1: sessionsservice.ListSessionsRequest.callerTypes:type_name -> sessionsservice.CallerType
+	4,  // 2: sessionsservice.ListSessionsRequest.calleeTypes:type_name -> sessionsservice.CallerType
+	39, // 3: sessionsservice.ListSessionsRequest.minDuration:type_name -> google.protobuf.Duration
+	40, // 4: sessionsservice.ListSessionsRequest.fieldMask:type_name -> google.protobuf.FieldMask
+	35, // 5: sessionsservice.ListSessionsReply.sessionCopy:type_name -> sessionsservice.SessionCopy
+	36, // 6: sessionsservice.VarMultiple.neededKeyValue:type_name -> sessionsservice.VarMultiple.NeededKeyValueEntry
+	15, // 7: sessionsservice.SetVarReply.results:type_name -> sessionsservice.UidResult
+	1,  // 8: sessionsservice.MonitorRequest.leg:type_name -> sessionsservice.MonitorLeg
+	0,  // 9: sessionsservice.MonitorRequest.mode:type_name -> sessionsservice.MonitorMode
+	37, // 10: sessionsservice.OriginateRequest.variables:type_name -> sessionsservice.OriginateRequest.VariablesEntry
+	2,  // 11: sessionsservice.Leg.role:type_name -> sessionsservice.LegRole
+	41, // 12: sessionsservice.Leg.dateStart:type_name -> google.protobuf.Timestamp
+	41, // 13: sessionsservice.Leg.dateEnd:type_name -> google.protobuf.Timestamp
+	3,  // 14: sessionsservice.CallHistoryEntry.change:type_name -> sessionsservice.CallChange
+	41, // 15: sessionsservice.CallHistoryEntry.date:type_name -> google.protobuf.Timestamp
+	26, // 16: sessionsservice.Call.legs:type_name -> sessionsservice.Leg
+	27, // 17: sessionsservice.Call.history:type_name -> sessionsservice.CallHistoryEntry
+	35, // 18: sessionsservice.Call.sessions:type_name -> sessionsservice.SessionCopy
+	6,  // 19: sessionsservice.SessionChange.changeType:type_name -> sessionsservice.SessionChange.ChangeType
+	35, // 20: sessionsservice.SessionChange.sessionCopy:type_name -> sessionsservice.SessionCopy
+	35, // 21: sessionsservice.SessionChange.snapshot:type_name -> sessionsservice.SessionCopy
+	41, // 22: sessionsservice.FreeswitchStatus.lastEventTime:type_name -> google.protobuf.Timestamp
+	32, // 23: sessionsservice.ServiceStatus.freeswitch:type_name -> sessionsservice.FreeswitchStatus
+	5,  // 24: sessionsservice.TransferCopy.kind:type_name -> sessionsservice.TransferKind
+	41, // 25: sessionsservice.TransferCopy.date:type_name -> google.protobuf.Timestamp
+	41, // 26: sessionsservice.SessionCopy.dateStart:type_name -> google.protobuf.Timestamp
+	41, // 27: sessionsservice.SessionCopy.dateRing:type_name -> google.protobuf.Timestamp
+	41, // 28: sessionsservice.SessionCopy.dateCon:type_name -> google.protobuf.Timestamp
+	41, // 29: sessionsservice.SessionCopy.dateEnd:type_name -> google.protobuf.Timestamp
+	4,  // 30: sessionsservice.SessionCopy.callerType:type_name -> sessionsservice.CallerType
+	4,  // 31: sessionsservice.SessionCopy.calleeType:type_name -> sessionsservice.CallerType
+	0,  // 32: sessionsservice.SessionCopy.monitorMode:type_name -> sessionsservice.MonitorMode
+	38, // 33: sessionsservice.SessionCopy.variables:type_name -> sessionsservice.SessionCopy.VariablesEntry
+	34, // 34: sessionsservice.SessionCopy.transfers:type_name -> sessionsservice.TransferCopy
+	41, // 35: sessionsservice.SessionCopy.holdStart:type_name -> google.protobuf.Timestamp
+	11, // 36: sessionsservice.SessionsService.GetSessionCopyService:input_type -> sessionsservice.CallerCalleeUid
+	7,  // 37: sessionsservice.SessionsService.GetSessionsCopyService:input_type -> sessionsservice.nil
+	9,  // 38: sessionsservice.SessionsService.ListSessions:input_type -> sessionsservice.ListSessionsRequest
+	12, // 39: sessionsservice.SessionsService.SetVar:input_type -> sessionsservice.Var
+	13, // 40: sessionsservice.SessionsService.SetVarMultiple:input_type -> sessionsservice.VarMultiple
+	30, // 41: sessionsservice.SessionsService.WatchSessions:input_type -> sessionsservice.WatchSessionsRequest
+	16, // 42: sessionsservice.SessionsService.InvalidateNumber:input_type -> sessionsservice.InvalidateNumberRequest
+	7,  // 43: sessionsservice.SessionsService.Status:input_type -> sessionsservice.nil
+	18, // 44: sessionsservice.SessionsService.Hangup:input_type -> sessionsservice.HangupRequest
+	19, // 45: sessionsservice.SessionsService.Transfer:input_type -> sessionsservice.TransferRequest
+	17, // 46: sessionsservice.SessionsService.Hold:input_type -> sessionsservice.CallControlRequest
+	17, // 47: sessionsservice.SessionsService.Unhold:input_type -> sessionsservice.CallControlRequest
+	20, // 48: sessionsservice.SessionsService.StartRecording:input_type -> sessionsservice.RecordingRequest
+	20, // 49: sessionsservice.SessionsService.StopRecording:input_type -> sessionsservice.RecordingRequest
+	17, // 50: sessionsservice.SessionsService.Park:input_type -> sessionsservice.CallControlRequest
+	22, // 51: sessionsservice.SessionsService.Monitor:input_type -> sessionsservice.MonitorRequest
+	24, // 52: sessionsservice.SessionsService.Originate:input_type -> sessionsservice.OriginateRequest
+	29, // 53: sessionsservice.SessionsService.GetCall:input_type -> sessionsservice.GetCallRequest
+	35, // 54: sessionsservice.SessionsService.GetSessionCopyService:output_type -> sessionsservice.SessionCopy
+	8,  // 55: sessionsservice.SessionsService.GetSessionsCopyService:output_type -> sessionsservice.SessionsCopy
+	10, // 56: sessionsservice.SessionsService.ListSessions:output_type -> sessionsservice.ListSessionsReply
+	14, // 57: sessionsservice.SessionsService.SetVar:output_type -> sessionsservice.SetVarReply
+	14, // 58: sessionsservice.SessionsService.SetVarMultiple:output_type -> sessionsservice.SetVarReply
+	31, // 59: sessionsservice.SessionsService.WatchSessions:output_type -> sessionsservice.SessionChange
+	42, // 60: sessionsservice.SessionsService.InvalidateNumber:output_type -> google.protobuf.BoolValue
+	33, // 61: sessionsservice.SessionsService.Status:output_type -> sessionsservice.ServiceStatus
+	21, // 62: sessionsservice.SessionsService.Hangup:output_type -> sessionsservice.CallControlReply
+	21, // 63: sessionsservice.SessionsService.Transfer:output_type -> sessionsservice.CallControlReply
+	21, // 64: sessionsservice.SessionsService.Hold:output_type -> sessionsservice.CallControlReply
+	21, // 65: sessionsservice.SessionsService.Unhold:output_type -> sessionsservice.CallControlReply
+	21, // 66: sessionsservice.SessionsService.StartRecording:output_type -> sessionsservice.CallControlReply
+	21, // 67: sessionsservice.SessionsService.StopRecording:output_type -> sessionsservice.CallControlReply
+	21, // 68: sessionsservice.SessionsService.Park:output_type -> sessionsservice.CallControlReply
+	23, // 69: sessionsservice.SessionsService.Monitor:output_type -> sessionsservice.MonitorReply
+	25, // 70: sessionsservice.SessionsService.Originate:output_type -> sessionsservice.OriginateReply
+	28, // 71: sessionsservice.SessionsService.GetCall:output_type -> sessionsservice.Call
+	54, // [54:72] is the sub-list for method output_type
+	36, // [36:54] is the sub-list for method input_type
+	36, // [36:36] is the sub-list for extension type_name
+	36, // [36:36] is the sub-list for extension extendee
+	0,  // [0:36] is the sub-list for field type_name
 }
 
 func init() { file_sessionsservice_proto_init() }
@@ -854,7 +3481,7 @@ func file_sessionsservice_proto_init() {
 			}
 		}
 		file_sessionsservice_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CallerCalleeUid); i {
+			switch v := v.(*ListSessionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -866,7 +3493,7 @@ func file_sessionsservice_proto_init() {
 			}
 		}
 		file_sessionsservice_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Var); i {
+			switch v := v.(*ListSessionsReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -878,7 +3505,7 @@ func file_sessionsservice_proto_init() {
 			}
 		}
 		file_sessionsservice_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VarMultiple); i {
+			switch v := v.(*CallerCalleeUid); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -890,6 +3517,282 @@ func file_sessionsservice_proto_init() {
 			}
 		}
 		file_sessionsservice_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Var); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sessionsservice_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VarMultiple); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sessionsservice_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetVarReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sessionsservice_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UidResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sessionsservice_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvalidateNumberRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sessionsservice_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CallControlRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sessionsservice_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HangupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sessionsservice_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sessionsservice_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sessionsservice_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CallControlReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sessionsservice_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MonitorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sessionsservice_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MonitorReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sessionsservice_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OriginateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sessionsservice_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OriginateReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sessionsservice_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Leg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sessionsservice_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CallHistoryEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sessionsservice_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Call); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sessionsservice_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCallRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sessionsservice_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchSessionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sessionsservice_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sessionsservice_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FreeswitchStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sessionsservice_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sessionsservice_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferCopy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sessionsservice_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SessionCopy); i {
 			case 0:
 				return &v.state
@@ -907,13 +3810,14 @@ func file_sessionsservice_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_sessionsservice_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   7,
+			NumEnums:      7,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_sessionsservice_proto_goTypes,
 		DependencyIndexes: file_sessionsservice_proto_depIdxs,
+		EnumInfos:         file_sessionsservice_proto_enumTypes,
 		MessageInfos:      file_sessionsservice_proto_msgTypes,
 	}.Build()
 	File_sessionsservice_proto = out.File
@@ -22,8 +22,22 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	SessionsService_GetSessionCopyService_FullMethodName  = "/sessionsservice.SessionsService/GetSessionCopyService"
 	SessionsService_GetSessionsCopyService_FullMethodName = "/sessionsservice.SessionsService/GetSessionsCopyService"
+	SessionsService_ListSessions_FullMethodName           = "/sessionsservice.SessionsService/ListSessions"
 	SessionsService_SetVar_FullMethodName                 = "/sessionsservice.SessionsService/SetVar"
 	SessionsService_SetVarMultiple_FullMethodName         = "/sessionsservice.SessionsService/SetVarMultiple"
+	SessionsService_WatchSessions_FullMethodName          = "/sessionsservice.SessionsService/WatchSessions"
+	SessionsService_InvalidateNumber_FullMethodName       = "/sessionsservice.SessionsService/InvalidateNumber"
+	SessionsService_Status_FullMethodName                 = "/sessionsservice.SessionsService/Status"
+	SessionsService_Hangup_FullMethodName                 = "/sessionsservice.SessionsService/Hangup"
+	SessionsService_Transfer_FullMethodName               = "/sessionsservice.SessionsService/Transfer"
+	SessionsService_Hold_FullMethodName                   = "/sessionsservice.SessionsService/Hold"
+	SessionsService_Unhold_FullMethodName                 = "/sessionsservice.SessionsService/Unhold"
+	SessionsService_StartRecording_FullMethodName         = "/sessionsservice.SessionsService/StartRecording"
+	SessionsService_StopRecording_FullMethodName          = "/sessionsservice.SessionsService/StopRecording"
+	SessionsService_Park_FullMethodName                   = "/sessionsservice.SessionsService/Park"
+	SessionsService_Monitor_FullMethodName                = "/sessionsservice.SessionsService/Monitor"
+	SessionsService_Originate_FullMethodName              = "/sessionsservice.SessionsService/Originate"
+	SessionsService_GetCall_FullMethodName                = "/sessionsservice.SessionsService/GetCall"
 )
 
 // SessionsServiceClient is the client API for SessionsService service.
@@ -32,8 +46,22 @@ const (
 type SessionsServiceClient interface {
 	GetSessionCopyService(ctx context.Context, in *CallerCalleeUid, opts ...grpc.CallOption) (*SessionCopy, error)
 	GetSessionsCopyService(ctx context.Context, in *Nil, opts ...grpc.CallOption) (*SessionsCopy, error)
-	SetVar(ctx context.Context, in *Var, opts ...grpc.CallOption) (*wrappers.BoolValue, error)
-	SetVarMultiple(ctx context.Context, in *VarMultiple, opts ...grpc.CallOption) (*wrappers.BoolValue, error)
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsReply, error)
+	SetVar(ctx context.Context, in *Var, opts ...grpc.CallOption) (*SetVarReply, error)
+	SetVarMultiple(ctx context.Context, in *VarMultiple, opts ...grpc.CallOption) (*SetVarReply, error)
+	WatchSessions(ctx context.Context, in *WatchSessionsRequest, opts ...grpc.CallOption) (SessionsService_WatchSessionsClient, error)
+	InvalidateNumber(ctx context.Context, in *InvalidateNumberRequest, opts ...grpc.CallOption) (*wrappers.BoolValue, error)
+	Status(ctx context.Context, in *Nil, opts ...grpc.CallOption) (*ServiceStatus, error)
+	Hangup(ctx context.Context, in *HangupRequest, opts ...grpc.CallOption) (*CallControlReply, error)
+	Transfer(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*CallControlReply, error)
+	Hold(ctx context.Context, in *CallControlRequest, opts ...grpc.CallOption) (*CallControlReply, error)
+	Unhold(ctx context.Context, in *CallControlRequest, opts ...grpc.CallOption) (*CallControlReply, error)
+	StartRecording(ctx context.Context, in *RecordingRequest, opts ...grpc.CallOption) (*CallControlReply, error)
+	StopRecording(ctx context.Context, in *RecordingRequest, opts ...grpc.CallOption) (*CallControlReply, error)
+	Park(ctx context.Context, in *CallControlRequest, opts ...grpc.CallOption) (*CallControlReply, error)
+	Monitor(ctx context.Context, in *MonitorRequest, opts ...grpc.CallOption) (*MonitorReply, error)
+	Originate(ctx context.Context, in *OriginateRequest, opts ...grpc.CallOption) (*OriginateReply, error)
+	GetCall(ctx context.Context, in *GetCallRequest, opts ...grpc.CallOption) (*Call, error)
 }
 
 type sessionsServiceClient struct {
@@ -62,8 +90,17 @@ func (c *sessionsServiceClient) GetSessionsCopyService(ctx context.Context, in *
 	return out, nil
 }
 
-func (c *sessionsServiceClient) SetVar(ctx context.Context, in *Var, opts ...grpc.CallOption) (*wrappers.BoolValue, error) {
-	out := new(wrappers.BoolValue)
+func (c *sessionsServiceClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsReply, error) {
+	out := new(ListSessionsReply)
+	err := c.cc.Invoke(ctx, SessionsService_ListSessions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsServiceClient) SetVar(ctx context.Context, in *Var, opts ...grpc.CallOption) (*SetVarReply, error) {
+	out := new(SetVarReply)
 	err := c.cc.Invoke(ctx, SessionsService_SetVar_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -71,8 +108,8 @@ func (c *sessionsServiceClient) SetVar(ctx context.Context, in *Var, opts ...grp
 	return out, nil
 }
 
-func (c *sessionsServiceClient) SetVarMultiple(ctx context.Context, in *VarMultiple, opts ...grpc.CallOption) (*wrappers.BoolValue, error) {
-	out := new(wrappers.BoolValue)
+func (c *sessionsServiceClient) SetVarMultiple(ctx context.Context, in *VarMultiple, opts ...grpc.CallOption) (*SetVarReply, error) {
+	out := new(SetVarReply)
 	err := c.cc.Invoke(ctx, SessionsService_SetVarMultiple_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -80,14 +117,168 @@ func (c *sessionsServiceClient) SetVarMultiple(ctx context.Context, in *VarMulti
 	return out, nil
 }
 
+func (c *sessionsServiceClient) WatchSessions(ctx context.Context, in *WatchSessionsRequest, opts ...grpc.CallOption) (SessionsService_WatchSessionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SessionsService_ServiceDesc.Streams[0], SessionsService_WatchSessions_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sessionsServiceWatchSessionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SessionsService_WatchSessionsClient interface {
+	Recv() (*SessionChange, error)
+	grpc.ClientStream
+}
+
+type sessionsServiceWatchSessionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *sessionsServiceWatchSessionsClient) Recv() (*SessionChange, error) {
+	m := new(SessionChange)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *sessionsServiceClient) InvalidateNumber(ctx context.Context, in *InvalidateNumberRequest, opts ...grpc.CallOption) (*wrappers.BoolValue, error) {
+	out := new(wrappers.BoolValue)
+	err := c.cc.Invoke(ctx, SessionsService_InvalidateNumber_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsServiceClient) Status(ctx context.Context, in *Nil, opts ...grpc.CallOption) (*ServiceStatus, error) {
+	out := new(ServiceStatus)
+	err := c.cc.Invoke(ctx, SessionsService_Status_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsServiceClient) Hangup(ctx context.Context, in *HangupRequest, opts ...grpc.CallOption) (*CallControlReply, error) {
+	out := new(CallControlReply)
+	err := c.cc.Invoke(ctx, SessionsService_Hangup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsServiceClient) Transfer(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*CallControlReply, error) {
+	out := new(CallControlReply)
+	err := c.cc.Invoke(ctx, SessionsService_Transfer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsServiceClient) Hold(ctx context.Context, in *CallControlRequest, opts ...grpc.CallOption) (*CallControlReply, error) {
+	out := new(CallControlReply)
+	err := c.cc.Invoke(ctx, SessionsService_Hold_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsServiceClient) Unhold(ctx context.Context, in *CallControlRequest, opts ...grpc.CallOption) (*CallControlReply, error) {
+	out := new(CallControlReply)
+	err := c.cc.Invoke(ctx, SessionsService_Unhold_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsServiceClient) StartRecording(ctx context.Context, in *RecordingRequest, opts ...grpc.CallOption) (*CallControlReply, error) {
+	out := new(CallControlReply)
+	err := c.cc.Invoke(ctx, SessionsService_StartRecording_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsServiceClient) StopRecording(ctx context.Context, in *RecordingRequest, opts ...grpc.CallOption) (*CallControlReply, error) {
+	out := new(CallControlReply)
+	err := c.cc.Invoke(ctx, SessionsService_StopRecording_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsServiceClient) Park(ctx context.Context, in *CallControlRequest, opts ...grpc.CallOption) (*CallControlReply, error) {
+	out := new(CallControlReply)
+	err := c.cc.Invoke(ctx, SessionsService_Park_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsServiceClient) Monitor(ctx context.Context, in *MonitorRequest, opts ...grpc.CallOption) (*MonitorReply, error) {
+	out := new(MonitorReply)
+	err := c.cc.Invoke(ctx, SessionsService_Monitor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsServiceClient) Originate(ctx context.Context, in *OriginateRequest, opts ...grpc.CallOption) (*OriginateReply, error) {
+	out := new(OriginateReply)
+	err := c.cc.Invoke(ctx, SessionsService_Originate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsServiceClient) GetCall(ctx context.Context, in *GetCallRequest, opts ...grpc.CallOption) (*Call, error) {
+	out := new(Call)
+	err := c.cc.Invoke(ctx, SessionsService_GetCall_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SessionsServiceServer is the server API for SessionsService service.
 // All implementations must embed UnimplementedSessionsServiceServer
 // for forward compatibility
 type SessionsServiceServer interface {
 	GetSessionCopyService(context.Context, *CallerCalleeUid) (*SessionCopy, error)
 	GetSessionsCopyService(context.Context, *Nil) (*SessionsCopy, error)
-	SetVar(context.Context, *Var) (*wrappers.BoolValue, error)
-	SetVarMultiple(context.Context, *VarMultiple) (*wrappers.BoolValue, error)
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsReply, error)
+	SetVar(context.Context, *Var) (*SetVarReply, error)
+	SetVarMultiple(context.Context, *VarMultiple) (*SetVarReply, error)
+	WatchSessions(*WatchSessionsRequest, SessionsService_WatchSessionsServer) error
+	InvalidateNumber(context.Context, *InvalidateNumberRequest) (*wrappers.BoolValue, error)
+	Status(context.Context, *Nil) (*ServiceStatus, error)
+	Hangup(context.Context, *HangupRequest) (*CallControlReply, error)
+	Transfer(context.Context, *TransferRequest) (*CallControlReply, error)
+	Hold(context.Context, *CallControlRequest) (*CallControlReply, error)
+	Unhold(context.Context, *CallControlRequest) (*CallControlReply, error)
+	StartRecording(context.Context, *RecordingRequest) (*CallControlReply, error)
+	StopRecording(context.Context, *RecordingRequest) (*CallControlReply, error)
+	Park(context.Context, *CallControlRequest) (*CallControlReply, error)
+	Monitor(context.Context, *MonitorRequest) (*MonitorReply, error)
+	Originate(context.Context, *OriginateRequest) (*OriginateReply, error)
+	GetCall(context.Context, *GetCallRequest) (*Call, error)
 	mustEmbedUnimplementedSessionsServiceServer()
 }
 
@@ -101,12 +292,54 @@ func (UnimplementedSessionsServiceServer) GetSessionCopyService(context.Context,
 func (UnimplementedSessionsServiceServer) GetSessionsCopyService(context.Context, *Nil) (*SessionsCopy, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSessionsCopyService not implemented")
 }
-func (UnimplementedSessionsServiceServer) SetVar(context.Context, *Var) (*wrappers.BoolValue, error) {
+func (UnimplementedSessionsServiceServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedSessionsServiceServer) SetVar(context.Context, *Var) (*SetVarReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetVar not implemented")
 }
-func (UnimplementedSessionsServiceServer) SetVarMultiple(context.Context, *VarMultiple) (*wrappers.BoolValue, error) {
+func (UnimplementedSessionsServiceServer) SetVarMultiple(context.Context, *VarMultiple) (*SetVarReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetVarMultiple not implemented")
 }
+func (UnimplementedSessionsServiceServer) WatchSessions(*WatchSessionsRequest, SessionsService_WatchSessionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchSessions not implemented")
+}
+func (UnimplementedSessionsServiceServer) InvalidateNumber(context.Context, *InvalidateNumberRequest) (*wrappers.BoolValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvalidateNumber not implemented")
+}
+func (UnimplementedSessionsServiceServer) Status(context.Context, *Nil) (*ServiceStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
+}
+func (UnimplementedSessionsServiceServer) Hangup(context.Context, *HangupRequest) (*CallControlReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Hangup not implemented")
+}
+func (UnimplementedSessionsServiceServer) Transfer(context.Context, *TransferRequest) (*CallControlReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Transfer not implemented")
+}
+func (UnimplementedSessionsServiceServer) Hold(context.Context, *CallControlRequest) (*CallControlReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Hold not implemented")
+}
+func (UnimplementedSessionsServiceServer) Unhold(context.Context, *CallControlRequest) (*CallControlReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unhold not implemented")
+}
+func (UnimplementedSessionsServiceServer) StartRecording(context.Context, *RecordingRequest) (*CallControlReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartRecording not implemented")
+}
+func (UnimplementedSessionsServiceServer) StopRecording(context.Context, *RecordingRequest) (*CallControlReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopRecording not implemented")
+}
+func (UnimplementedSessionsServiceServer) Park(context.Context, *CallControlRequest) (*CallControlReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Park not implemented")
+}
+func (UnimplementedSessionsServiceServer) Monitor(context.Context, *MonitorRequest) (*MonitorReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Monitor not implemented")
+}
+func (UnimplementedSessionsServiceServer) Originate(context.Context, *OriginateRequest) (*OriginateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Originate not implemented")
+}
+func (UnimplementedSessionsServiceServer) GetCall(context.Context, *GetCallRequest) (*Call, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCall not implemented")
+}
 func (UnimplementedSessionsServiceServer) mustEmbedUnimplementedSessionsServiceServer() {}
 
 // UnsafeSessionsServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -156,6 +389,24 @@ func _SessionsService_GetSessionsCopyService_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SessionsService_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServiceServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionsService_ListSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServiceServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SessionsService_SetVar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Var)
 	if err := dec(in); err != nil {
@@ -192,6 +443,243 @@ func _SessionsService_SetVarMultiple_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SessionsService_WatchSessions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchSessionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SessionsServiceServer).WatchSessions(m, &sessionsServiceWatchSessionsServer{stream})
+}
+
+type SessionsService_WatchSessionsServer interface {
+	Send(*SessionChange) error
+	grpc.ServerStream
+}
+
+type sessionsServiceWatchSessionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *sessionsServiceWatchSessionsServer) Send(m *SessionChange) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SessionsService_InvalidateNumber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvalidateNumberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServiceServer).InvalidateNumber(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionsService_InvalidateNumber_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServiceServer).InvalidateNumber(ctx, req.(*InvalidateNumberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionsService_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Nil)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServiceServer).Status(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionsService_Status_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServiceServer).Status(ctx, req.(*Nil))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionsService_Hangup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HangupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServiceServer).Hangup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionsService_Hangup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServiceServer).Hangup(ctx, req.(*HangupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionsService_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServiceServer).Transfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionsService_Transfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServiceServer).Transfer(ctx, req.(*TransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionsService_Hold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallControlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServiceServer).Hold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionsService_Hold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServiceServer).Hold(ctx, req.(*CallControlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionsService_Unhold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallControlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServiceServer).Unhold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionsService_Unhold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServiceServer).Unhold(ctx, req.(*CallControlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionsService_StartRecording_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServiceServer).StartRecording(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionsService_StartRecording_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServiceServer).StartRecording(ctx, req.(*RecordingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionsService_StopRecording_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServiceServer).StopRecording(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionsService_StopRecording_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServiceServer).StopRecording(ctx, req.(*RecordingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionsService_Park_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallControlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServiceServer).Park(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionsService_Park_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServiceServer).Park(ctx, req.(*CallControlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionsService_Monitor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MonitorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServiceServer).Monitor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionsService_Monitor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServiceServer).Monitor(ctx, req.(*MonitorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionsService_Originate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OriginateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServiceServer).Originate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionsService_Originate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServiceServer).Originate(ctx, req.(*OriginateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionsService_GetCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServiceServer).GetCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionsService_GetCall_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServiceServer).GetCall(ctx, req.(*GetCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SessionsService_ServiceDesc is the grpc.ServiceDesc for SessionsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -207,6 +695,10 @@ var SessionsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSessionsCopyService",
 			Handler:    _SessionsService_GetSessionsCopyService_Handler,
 		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _SessionsService_ListSessions_Handler,
+		},
 		{
 			MethodName: "SetVar",
 			Handler:    _SessionsService_SetVar_Handler,
@@ -215,7 +707,61 @@ var SessionsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetVarMultiple",
 			Handler:    _SessionsService_SetVarMultiple_Handler,
 		},
+		{
+			MethodName: "InvalidateNumber",
+			Handler:    _SessionsService_InvalidateNumber_Handler,
+		},
+		{
+			MethodName: "Status",
+			Handler:    _SessionsService_Status_Handler,
+		},
+		{
+			MethodName: "Hangup",
+			Handler:    _SessionsService_Hangup_Handler,
+		},
+		{
+			MethodName: "Transfer",
+			Handler:    _SessionsService_Transfer_Handler,
+		},
+		{
+			MethodName: "Hold",
+			Handler:    _SessionsService_Hold_Handler,
+		},
+		{
+			MethodName: "Unhold",
+			Handler:    _SessionsService_Unhold_Handler,
+		},
+		{
+			MethodName: "StartRecording",
+			Handler:    _SessionsService_StartRecording_Handler,
+		},
+		{
+			MethodName: "StopRecording",
+			Handler:    _SessionsService_StopRecording_Handler,
+		},
+		{
+			MethodName: "Park",
+			Handler:    _SessionsService_Park_Handler,
+		},
+		{
+			MethodName: "Monitor",
+			Handler:    _SessionsService_Monitor_Handler,
+		},
+		{
+			MethodName: "Originate",
+			Handler:    _SessionsService_Originate_Handler,
+		},
+		{
+			MethodName: "GetCall",
+			Handler:    _SessionsService_GetCall_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchSessions",
+			Handler:       _SessionsService_WatchSessions_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "sessionsservice.proto",
 }
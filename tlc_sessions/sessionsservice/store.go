@@ -0,0 +1,239 @@
+package sessionsservice
+
+import (
+	"sort"
+	"sync"
+)
+
+// Store keeps the sessions indexed by caller uid, callee uid and caller/callee pair.
+// Ids are given in insertion order, so the lowest matching id is the session that
+// a scan of the list in insertion order would have found first.
+// The package keeps no store of its own: the server owns its stores, and its callers hold Lock
+// around the other methods.
+type Store struct {
+	mutex    sync.Mutex
+	nextId   int
+	sessions map[int]*Session
+	byCaller map[string]map[int]bool
+	byCallee map[string]map[int]bool
+	byPair   map[string]map[int]bool
+}
+
+func NewStore() *Store {
+	return &Store{
+		sessions: make(map[int]*Session),
+		byCaller: make(map[string]map[int]bool),
+		byCallee: make(map[string]map[int]bool),
+		byPair:   make(map[string]map[int]bool),
+	}
+}
+
+func pairKey(callerUid string, calleeUid string) string {
+	return callerUid + "|" + calleeUid
+}
+
+func addToIndex(index map[string]map[int]bool, key string, id int) {
+	if index[key] == nil {
+		index[key] = make(map[int]bool)
+	}
+	index[key][id] = true
+}
+
+func removeFromIndex(index map[string]map[int]bool, key string, id int) {
+	delete(index[key], id)
+	if len(index[key]) == 0 {
+		delete(index, key)
+	}
+}
+
+func firstId(ids map[int]bool) (int, bool) {
+	var first int
+	var found bool
+	for id := range ids {
+		if !found || id < first {
+			first = id
+			found = true
+		}
+	}
+	return first, found
+}
+
+func (store *Store) Lock() {
+	store.mutex.Lock()
+}
+
+func (store *Store) Unlock() {
+	store.mutex.Unlock()
+}
+
+func (store *Store) Len() int {
+	return len(store.sessions)
+}
+
+func (store *Store) index(id int) {
+	session := store.sessions[id]
+	addToIndex(store.byCaller, session.CallerUid, id)
+	addToIndex(store.byCallee, session.CalleeUid, id)
+	addToIndex(store.byPair, pairKey(session.CallerUid, session.CalleeUid), id)
+}
+
+func (store *Store) unindex(id int) {
+	session := store.sessions[id]
+	removeFromIndex(store.byCaller, session.CallerUid, id)
+	removeFromIndex(store.byCallee, session.CalleeUid, id)
+	removeFromIndex(store.byPair, pairKey(session.CallerUid, session.CalleeUid), id)
+}
+
+// Add a copy of session and return its id
+func (store *Store) Add(session Session) int {
+	id := store.nextId
+	store.nextId++
+	store.sessions[id] = &session
+	store.index(id)
+	return id
+}
+
+// Replace the session stored under id, keeping its position, and return the replaced one
+func (store *Store) Update(id int, session Session) (*Session, bool) {
+	previous, exist := store.sessions[id]
+	if !exist {
+		return nil, false
+	}
+	store.unindex(id)
+	store.sessions[id] = &session
+	store.index(id)
+	return previous, true
+}
+
+// Replace all the sessions
+func (store *Store) Set(list []Session) {
+	store.nextId = 0
+	store.sessions = make(map[int]*Session)
+	store.byCaller = make(map[string]map[int]bool)
+	store.byCallee = make(map[string]map[int]bool)
+	store.byPair = make(map[string]map[int]bool)
+	for _, session := range list {
+		store.Add(session)
+	}
+}
+
+// Copy of the sessions in insertion order
+func (store *Store) List() []Session {
+	ids := make([]int, 0, len(store.sessions))
+	for id := range store.sessions {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	list := make([]Session, 0, len(ids))
+	for _, id := range ids {
+		list = append(list, *store.sessions[id])
+	}
+	return list
+}
+
+func (store *Store) found(id int) (*Session, int, bool) {
+	session := *store.sessions[id]
+	return &session, id, true
+}
+
+// Same fallback order as a scan of the list: exact pair, reversed pair, then one leg known and the other empty,
+// then (onlyOneUid) any session having one of the uids
+func (store *Store) Get(callerUid string, calleeUid string, exactly bool, onlyOneUid bool) (*Session, int, bool) {
+	if exactly {
+		for _, key := range []string{pairKey(callerUid, calleeUid), pairKey(calleeUid, callerUid)} {
+			if id, found := firstId(store.byPair[key]); found {
+				return store.found(id)
+			}
+		}
+		return nil, 0, false
+	}
+	if calleeUid == "" {
+		calleeUid = "not_exist"
+	}
+	if callerUid == "" {
+		callerUid = "not_exist"
+	}
+	for _, key := range []string{
+		pairKey(callerUid, calleeUid),
+		pairKey(calleeUid, callerUid),
+		pairKey(callerUid, ""),
+		pairKey("", calleeUid),
+		pairKey("", callerUid),
+		pairKey(calleeUid, ""),
+	} {
+		if id, found := firstId(store.byPair[key]); found {
+			return store.found(id)
+		}
+	}
+	if onlyOneUid {
+		for _, ids := range []map[int]bool{store.byCaller[callerUid], store.byCallee[calleeUid], store.byCaller[calleeUid], store.byCallee[callerUid]} {
+			if id, found := firstId(ids); found {
+				return store.found(id)
+			}
+		}
+	}
+	return nil, 0, false
+}
+
+// Remove the first session matching the pair, the reversed pair, then any session having one of the uids
+func (store *Store) Remove(callerUid string, calleeUid string) (*Session, bool) {
+	for _, ids := range []map[int]bool{
+		store.byPair[pairKey(callerUid, calleeUid)],
+		store.byPair[pairKey(calleeUid, callerUid)],
+		store.byCaller[callerUid],
+		store.byCallee[calleeUid],
+		store.byCallee[callerUid],
+		store.byCaller[calleeUid],
+	} {
+		if id, found := firstId(ids); found {
+			session := store.sessions[id]
+			store.unindex(id)
+			delete(store.sessions, id)
+			return session, true
+		}
+	}
+	return nil, false
+}
+
+// Copies and ids of all the sessions having the uid as caller or callee, in insertion order
+func (store *Store) WithUid(uid string) ([]Session, []int) {
+	var ids []int
+	for id := range store.byCaller[uid] {
+		ids = append(ids, id)
+	}
+	for id := range store.byCallee[uid] {
+		if !store.byCaller[uid][id] {
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+	list := make([]Session, 0, len(ids))
+	for _, id := range ids {
+		list = append(list, *store.sessions[id])
+	}
+	return list, ids
+}
+
+// Remove the session at id
+func (store *Store) RemoveId(id int) (*Session, bool) {
+	session, exist := store.sessions[id]
+	if !exist {
+		return nil, false
+	}
+	store.unindex(id)
+	delete(store.sessions, id)
+	return session, true
+}
+
+// Remove all the sessions matching and return them
+func (store *Store) RemoveWhere(match func(session *Session) bool) []Session {
+	var removed []Session
+	for id, session := range store.sessions {
+		if match(session) {
+			removed = append(removed, *session)
+			store.unindex(id)
+			delete(store.sessions, id)
+		}
+	}
+	return removed
+}
@@ -0,0 +1,174 @@
+package sessionsservice
+
+import (
+	"strconv"
+	"testing"
+)
+
+// Reference implementation: the scan of the list the store replaces
+func scanSession(list []Session, callerUid string, calleeUid string, exactly bool, onlyOneUid bool) (Session, bool) {
+	type match func(session Session) bool
+	var matches []match
+	if exactly {
+		matches = []match{
+			func(s Session) bool { return s.CallerUid == callerUid && s.CalleeUid == calleeUid },
+			func(s Session) bool { return s.CallerUid == calleeUid && s.CalleeUid == callerUid },
+		}
+	} else {
+		if calleeUid == "" {
+			calleeUid = "not_exist"
+		}
+		if callerUid == "" {
+			callerUid = "not_exist"
+		}
+		matches = []match{
+			func(s Session) bool { return s.CallerUid == callerUid && s.CalleeUid == calleeUid },
+			func(s Session) bool { return s.CallerUid == calleeUid && s.CalleeUid == callerUid },
+			func(s Session) bool { return s.CallerUid == callerUid && s.CalleeUid == "" },
+			func(s Session) bool { return s.CalleeUid == calleeUid && s.CallerUid == "" },
+			func(s Session) bool { return s.CalleeUid == callerUid && s.CallerUid == "" },
+			func(s Session) bool { return s.CallerUid == calleeUid && s.CalleeUid == "" },
+		}
+		if onlyOneUid {
+			matches = append(matches,
+				func(s Session) bool { return s.CallerUid == callerUid },
+				func(s Session) bool { return s.CalleeUid == calleeUid },
+				func(s Session) bool { return s.CallerUid == calleeUid },
+				func(s Session) bool { return s.CalleeUid == callerUid },
+			)
+		}
+	}
+	for _, m := range matches {
+		for _, session := range list {
+			if m(session) {
+				return session, true
+			}
+		}
+	}
+	return Session{}, false
+}
+
+func TestStoreGetMatchesScan(t *testing.T) {
+	list := []Session{
+		{CallerUid: "a", CalleeUid: "b", CallerNum: "1"},
+		{CallerUid: "b", CalleeUid: "a", CallerNum: "2"},
+		{CallerUid: "c", CalleeUid: "", CallerNum: "3"},
+		{CallerUid: "", CalleeUid: "d", CallerNum: "4"},
+		{CallerUid: "e", CalleeUid: "f", CallerNum: "5"},
+		{CallerUid: "e", CalleeUid: "g", CallerNum: "6"},
+		{CallerUid: "h", CalleeUid: "e", CallerNum: "7"},
+		{CallerUid: "", CalleeUid: "", CallerNum: "8"},
+	}
+	store := NewStore()
+	store.Set(list)
+	uids := []string{"", "a", "b", "c", "d", "e", "f", "g", "h", "x"}
+	for _, callerUid := range uids {
+		for _, calleeUid := range uids {
+			for _, exactly := range []bool{false, true} {
+				for _, onlyOneUid := range []bool{false, true} {
+					want, wantFound := scanSession(list, callerUid, calleeUid, exactly, onlyOneUid)
+					got, _, gotFound := store.Get(callerUid, calleeUid, exactly, onlyOneUid)
+					if gotFound != wantFound || (gotFound && got.CallerNum != want.CallerNum) {
+						t.Errorf("Get(%q, %q, %v, %v) = %+v, %v, want %+v, %v", callerUid, calleeUid, exactly, onlyOneUid, got, gotFound, want, wantFound)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestStoreRemoveKeepsOrder(t *testing.T) {
+	store := NewStore()
+	store.Set([]Session{
+		{CallerUid: "a", CalleeUid: "b", CallerNum: "1"},
+		{CallerUid: "c", CalleeUid: "d", CallerNum: "2"},
+		{CallerUid: "a", CalleeUid: "e", CallerNum: "3"},
+	})
+	removed, found := store.Remove("a", "x")
+	if !found || removed.CallerNum != "1" {
+		t.Fatalf("Remove(a, x) = %+v, %v, want session 1", removed, found)
+	}
+	list := store.List()
+	if len(list) != 2 || list[0].CallerNum != "2" || list[1].CallerNum != "3" {
+		t.Fatalf("List() = %+v, want sessions 2 and 3", list)
+	}
+	id := store.Add(Session{CallerUid: "f", CalleeUid: "g", CallerNum: "4"})
+	store.Update(id, Session{CallerUid: "f", CalleeUid: "h", CallerNum: "4"})
+	if _, _, found := store.Get("f", "g", true, false); found {
+		t.Fatalf("Get(f, g) found a session after its callee uid changed")
+	}
+	if session, _, found := store.Get("f", "h", true, false); !found || session.CallerNum != "4" {
+		t.Fatalf("Get(f, h) = %+v, %v, want session 4", session, found)
+	}
+}
+
+func benchmarkSessions(n int) []Session {
+	list := make([]Session, 0, n)
+	for i := 0; i < n; i++ {
+		list = append(list, Session{CallerUid: "caller-" + strconv.Itoa(i), CalleeUid: "callee-" + strconv.Itoa(i)})
+	}
+	return list
+}
+
+func BenchmarkStoreGetExactly10k(b *testing.B) {
+	store := NewStore()
+	store.Set(benchmarkSessions(10000))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		store.Get("caller-9999", "callee-9999", true, false)
+	}
+}
+
+func BenchmarkStoreGetOnlyOneUid10k(b *testing.B) {
+	store := NewStore()
+	store.Set(benchmarkSessions(10000))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		store.Get("callee-9999", "", false, true)
+	}
+}
+
+func BenchmarkStoreGetNotFound10k(b *testing.B) {
+	store := NewStore()
+	store.Set(benchmarkSessions(10000))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		store.Get("unknown", "unknown", false, true)
+	}
+}
+
+func BenchmarkScanGetNotFound10k(b *testing.B) {
+	list := benchmarkSessions(10000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		scanSession(list, "unknown", "unknown", false, true)
+	}
+}
+
+func BenchmarkStoreAddRemove10k(b *testing.B) {
+	store := NewStore()
+	store.Set(benchmarkSessions(10000))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		store.Add(Session{CallerUid: "caller-new", CalleeUid: "callee-new"})
+		store.Remove("caller-new", "callee-new")
+	}
+}
+
+func TestStoreWithUid(t *testing.T) {
+	store := NewStore()
+	store.Set([]Session{{CallerUid: "a", CalleeUid: "b"}, {CallerUid: "c", CalleeUid: "d"}, {CallerUid: "b", CalleeUid: "e"}, {CallerUid: "b", CalleeUid: "b"}})
+	list, ids := store.WithUid("b")
+	if len(list) != 3 || len(ids) != 3 || list[0].CallerUid != "a" || list[1].CalleeUid != "e" || list[2].CalleeUid != "b" {
+		t.Fatalf("WithUid(b) = %+v / %v", list, ids)
+	}
+	if removed, found := store.RemoveId(ids[1]); !found || removed.CalleeUid != "e" {
+		t.Fatalf("RemoveId = %+v, %v", removed, found)
+	}
+	if list, _ := store.WithUid("b"); len(list) != 2 || store.Len() != 3 {
+		t.Errorf("after RemoveId : %+v", list)
+	}
+	if _, found := store.RemoveId(ids[1]); found {
+		t.Errorf("removed twice")
+	}
+}
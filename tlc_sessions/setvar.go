@@ -0,0 +1,216 @@
+package main
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fetristan/tlc_sessions/sessionsservice"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Wait of the result of one uuid_setvar job
+const setVarJobTimeout = 10 * time.Second
+
+func checkVariableName(key string) error {
+	if err := checkCommandArg("variable name", key, true); err != nil {
+		return err
+	}
+	if strings.ContainsAny(key, "=;,{}'") {
+		return status.Errorf(codes.InvalidArgument, "variable name %s must not contain = ; , { } or '", key)
+	}
+	return nil
+}
+
+// Value of a variable, the rest of the command line for uuid_setvar, one item of a ; separated list for uuid_setvar_multi
+func checkVariableValue(key string, value string, multi bool) error {
+	if strings.IndexFunc(value, func(r rune) bool { return r != ' ' && (r < ' ' || r == 0x7f) }) >= 0 {
+		return status.Errorf(codes.InvalidArgument, "value of %s must not contain control characters", key)
+	}
+	if multi && strings.ContainsAny(value, ";=") {
+		return status.Errorf(codes.InvalidArgument, "value of %s must not contain ; or = when set with other variables", key)
+	}
+	return nil
+}
+
+func setVarCommand(uuid string, key string, value string) (string, error) {
+	if err := checkVariableName(key); err != nil {
+		return "", err
+	}
+	if err := checkVariableValue(key, value, false); err != nil {
+		return "", err
+	}
+	return "uuid_setvar " + uuid + " " + key + " " + value, nil
+}
+
+// Uuid and variables of a uuid_setvar or uuid_setvar_multi command, the value of uuid_setvar being the rest of the line
+func parseSetVarArgs(command string, argument string) (string, map[string]string) {
+	keyValues := make(map[string]string)
+	args := strings.SplitN(argument, " ", 2)
+	if len(args) < 2 {
+		return args[0], keyValues
+	}
+	items := strings.Split(args[1], ";")
+	separator := "="
+	if command == "uuid_setvar" {
+		items, separator = []string{args[1]}, " "
+	}
+	for _, item := range items {
+		keyValue := strings.SplitN(item, separator, 2)
+		if len(keyValue) > 1 {
+			keyValues[keyValue[0]] = keyValue[1]
+		} else {
+			keyValues[keyValue[0]] = ""
+		}
+	}
+	return args[0], keyValues
+}
+
+func setVarMultiCommand(uuid string, keyValues map[string]string) (string, error) {
+	if len(keyValues) == 0 {
+		return "", status.Error(codes.InvalidArgument, "no variable to set")
+	}
+	var items []string
+	for key, value := range keyValues {
+		if err := checkVariableName(key); err != nil {
+			return "", err
+		}
+		if err := checkVariableValue(key, value, true); err != nil {
+			return "", err
+		}
+		items = append(items, key+"="+value)
+	}
+	sort.Strings(items)
+	return "uuid_setvar_multi " + uuid + " " + strings.Join(items, ";"), nil
+}
+
+// Uuids of the request, both belonging to the same session when both are given, the session owning them being on connIdx
+func setVarTarget(callerUid string, calleeUid string) ([]string, int, error) {
+	var uuids []string
+	for name, uid := range map[string]string{"callerUid": callerUid, "calleeUid": calleeUid} {
+		if err := checkCommandArg(name, uid, false); err != nil {
+			return nil, 0, err
+		}
+	}
+	for _, uid := range []string{callerUid, calleeUid} {
+		if uid != "" {
+			uuids = append(uuids, uid)
+		}
+	}
+	if len(uuids) == 0 {
+		return nil, 0, status.Error(codes.InvalidArgument, "callerUid or calleeUid is required")
+	}
+	if len(uuids) == 2 {
+		activeSessions.Lock()
+		_, _, found := activeSessions.Get(callerUid, calleeUid, true, false)
+		activeSessions.Unlock()
+		if !found {
+			return nil, 0, status.Errorf(codes.NotFound, "no session for uids %s and %s", callerUid, calleeUid)
+		}
+	}
+	connIdx, err := sessionOwner(uuids[0])
+	if err != nil {
+		return nil, 0, err
+	}
+	if connIdx >= len(fs) || fs[connIdx] == nil || !fs[connIdx].Connected() {
+		return nil, 0, status.Errorf(codes.Unavailable, "FreeSWITCH %d is not connected", connIdx)
+	}
+	return uuids, connIdx, nil
+}
+
+// Result of one job, waited until ctx is done or the job timeout
+func waitSetVarJob(ctx context.Context, uuid string, reply chan string, err error) *sessionsservice.UidResult {
+	result := &sessionsservice.UidResult{Uid: uuid}
+	if err == nil {
+		select {
+		case answer := <-reply:
+			result.Reply = strings.TrimSpace(answer)
+			err = apiReplyError(answer, nil)
+		case <-ctx.Done():
+			err = status.FromContextError(ctx.Err()).Err()
+		case <-time.After(setVarJobTimeout):
+			err = status.Errorf(codes.DeadlineExceeded, "no result for uuid %s", uuid)
+		}
+	} else {
+		err = apiReplyError("", err)
+	}
+	result.Ok = err == nil
+	result.Code = int32(status.Code(err))
+	if err != nil && result.Reply == "" {
+		result.Reply = status.Convert(err).Message()
+	}
+	return result
+}
+
+// Send one command per uuid as background jobs and wait for their results
+func sendSetVarJobs(ctx context.Context, connIdx int, uuids []string, command func(uuid string) (string, error)) (*sessionsservice.SetVarReply, error) {
+	commands := make([]string, len(uuids))
+	for i, uuid := range uuids {
+		var err error
+		if commands[i], err = command(uuid); err != nil {
+			return nil, err
+		}
+	}
+	replies := make([]chan string, len(uuids))
+	errs := make([]error, len(uuids))
+	for i := range uuids {
+		log.Debugf("SetVar : FreeSWITCH %d : %s", connIdx, commands[i])
+		replies[i], errs[i] = fs[connIdx].SendBgapiCmd(commands[i])
+	}
+	reply := &sessionsservice.SetVarReply{Value: true}
+	for i, uuid := range uuids {
+		result := waitSetVarJob(ctx, uuid, replies[i], errs[i])
+		if !result.Ok {
+			log.Errorf("SetVar : FreeSWITCH %d : %s : %s", connIdx, commands[i], result.Reply)
+		}
+		reply.Value = reply.Value && result.Ok
+		reply.Results = append(reply.Results, result)
+	}
+	return reply, nil
+}
+
+// Set variable to session from GRPC
+func (s *server) SetVar(ctx context.Context, in *sessionsservice.Var) (*sessionsservice.SetVarReply, error) {
+	log.Debugf("Received:SetVar : %s / %s / %s", in.GetCallerUid(), in.GetCalleeUid(), in.GetNeededKey())
+	uuids, connIdx, err := setVarTarget(in.GetCallerUid(), in.GetCalleeUid())
+	if err != nil {
+		return nil, err
+	}
+	return sendSetVarJobs(ctx, connIdx, uuids, func(uuid string) (string, error) {
+		return setVarCommand(uuid, in.GetNeededKey(), in.GetNeededValue())
+	})
+}
+
+// Set multiple variable from GRPC
+func (s *server) SetVarMultiple(ctx context.Context, in *sessionsservice.VarMultiple) (*sessionsservice.SetVarReply, error) {
+	log.Debugf("Received:SetVarMultiple : %s / %s / %d variables", in.GetCallerUid(), in.GetCalleeUid(), len(in.GetNeededKeyValue()))
+	uuids, connIdx, err := setVarTarget(in.GetCallerUid(), in.GetCalleeUid())
+	if err != nil {
+		return nil, err
+	}
+	return sendSetVarJobs(ctx, connIdx, uuids, func(uuid string) (string, error) {
+		return setVarMultiCommand(uuid, in.GetNeededKeyValue())
+	})
+}
+
+// Set multiple variable to session without waiting, on its node only if it is known
+func SetVarMultiple(node string, callerUuid string, calleeUuid string, neededKeyValue map[string]string) {
+	if len(neededKeyValue) == 0 {
+		return
+	}
+	for _, uuid := range []string{callerUuid, calleeUuid} {
+		if uuid == "" {
+			continue
+		}
+		command, err := setVarMultiCommand(uuid, neededKeyValue)
+		if err != nil {
+			log.Errorf("SetVar : %s : %s", uuid, err)
+			continue
+		}
+		for _, oneFs := range nodeFreeswitch(node) {
+			oneFs.SendBgapiCmd(command)
+		}
+	}
+}
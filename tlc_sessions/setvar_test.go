@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/fetristan/tlc_sessions/sessionsservice"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSetVarCommands(t *testing.T) {
+	if command, err := setVarCommand("uid", "agent_name", "Jane Doe; team=A"); err != nil || command != "uuid_setvar uid agent_name Jane Doe; team=A" {
+		t.Errorf("setVarCommand = %q, %v", command, err)
+	}
+	if command, err := setVarMultiCommand("uid", map[string]string{"b": "2", "a": "one two"}); err != nil || command != "uuid_setvar_multi uid a=one two;b=2" {
+		t.Errorf("setVarMultiCommand = %q, %v", command, err)
+	}
+	for _, test := range []struct{ command, key, value string }{
+		{"uuid_setvar", "agent_name", "Jane Doe; team=A"},
+		{"uuid_setvar", "agent_name", "a=b"},
+		{"uuid_setvar_multi", "agent_name", "one two"},
+	} {
+		var command string
+		if test.command == "uuid_setvar" {
+			command, _ = setVarCommand("uid", test.key, test.value)
+		} else {
+			command, _ = setVarMultiCommand("uid", map[string]string{test.key: test.value, "b": "2"})
+		}
+		// The command as mirrored into the session by apiCommand
+		uuid, keyValues := parseSetVarArgs(test.command, strings.TrimPrefix(command, test.command+" "))
+		session := mergeEventMapIntoSession(keyValues, &sessionsservice.Session{CallerUid: "uid"})
+		if uuid != "uid" || session.Variables[test.key] != test.value || len(session.Variables) != len(keyValues) {
+			t.Errorf("%s mirrored as %s %+v, want %s=%q", command, uuid, session.Variables, test.key, test.value)
+		}
+	}
+	for _, test := range []struct {
+		name string
+		err  error
+	}{
+		{"key with space", func() error { _, err := setVarCommand("uid", "a b", "1"); return err }()},
+		{"key with =", func() error { _, err := setVarCommand("uid", "a=b", "1"); return err }()},
+		{"value with newline", func() error { _, err := setVarCommand("uid", "a", "1\napi hupall"); return err }()},
+		{"multi value with ;", func() error { _, err := setVarMultiCommand("uid", map[string]string{"a": "1;b=2"}); return err }()},
+		{"multi without variable", func() error { _, err := setVarMultiCommand("uid", nil); return err }()},
+	} {
+		if status.Code(test.err) != codes.InvalidArgument {
+			t.Errorf("%s : %v, want InvalidArgument", test.name, test.err)
+		}
+	}
+}
+
+func TestWaitSetVarJob(t *testing.T) {
+	reply := make(chan string, 1)
+	reply <- "+OK\n"
+	if result := waitSetVarJob(context.Background(), "a", reply, nil); !result.Ok || result.Reply != "+OK" {
+		t.Errorf("ok job : %+v", result)
+	}
+	reply <- "-ERR No such channel!\n"
+	if result := waitSetVarJob(context.Background(), "b", reply, nil); result.Ok || codes.Code(result.Code) != codes.FailedPrecondition || result.Reply != "-ERR No such channel!" {
+		t.Errorf("failed job : %+v", result)
+	}
+	if result := waitSetVarJob(context.Background(), "c", nil, errors.New("not connected")); result.Ok || codes.Code(result.Code) != codes.Unavailable {
+		t.Errorf("send error : %+v", result)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if result := waitSetVarJob(ctx, "d", make(chan string), nil); result.Ok || codes.Code(result.Code) != codes.Canceled {
+		t.Errorf("canceled : %+v", result)
+	}
+}
+
+func TestSetVarSessionNotFound(t *testing.T) {
+	setTestSessions(t, nil)
+	if _, err := (&server{}).SetVar(context.Background(), &sessionsservice.Var{CallerUid: "missing", NeededKey: "a"}); status.Code(err) != codes.NotFound {
+		t.Errorf("missing session : %v", err)
+	}
+	if _, err := (&server{}).SetVarMultiple(context.Background(), &sessionsservice.VarMultiple{}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("no uid : %v", err)
+	}
+}
+
+func TestSetVarUidsOfAnotherSession(t *testing.T) {
+	setTestSessions(t, []sessionsservice.Session{{CallerUid: "a", CalleeUid: "b"}, {CallerUid: "c", CalleeUid: "d"}})
+	if _, err := (&server{}).SetVar(context.Background(), &sessionsservice.Var{CallerUid: "a", CalleeUid: "d", NeededKey: "k"}); status.Code(err) != codes.NotFound {
+		t.Errorf("uids of two sessions : %v", err)
+	}
+	if _, err := (&server{}).SetVar(context.Background(), &sessionsservice.Var{CallerUid: "a", CalleeUid: "untracked", NeededKey: "k"}); status.Code(err) != codes.NotFound {
+		t.Errorf("untracked callee : %v", err)
+	}
+	// Both uids of one session pass the check, no node being connected
+	if _, err := (&server{}).SetVar(context.Background(), &sessionsservice.Var{CallerUid: "b", CalleeUid: "a", NeededKey: "k"}); status.Code(err) != codes.Unavailable {
+		t.Errorf("uids of one session : %v", err)
+	}
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+)
+
+// Used when sessions shutdown_timeout is not configured
+const defaultShutdownTimeout = 10 * time.Second
+
+// Gate of the event handlers, closed at shutdown to wait for the running ones and drop the next ones
+type handlerGate struct {
+	mutex   sync.Mutex
+	closed  bool
+	running sync.WaitGroup
+}
+
+var eventHandlersGate handlerGate
+
+func (gate *handlerGate) enter() bool {
+	gate.mutex.Lock()
+	defer gate.mutex.Unlock()
+	if gate.closed {
+		return false
+	}
+	gate.running.Add(1)
+	return true
+}
+
+// Close the gate and wait for the running handlers until ctx is done
+func (gate *handlerGate) close(ctx context.Context) error {
+	gate.mutex.Lock()
+	gate.closed = true
+	gate.mutex.Unlock()
+	return waitUntil(ctx, gate.running.Wait)
+}
+
+// Wrap the handlers to let the shutdown wait for them through gate
+func gateHandlers(gate *handlerGate, evHandlers map[string][]func(string, int)) map[string][]func(string, int) {
+	gated := make(map[string][]func(string, int))
+	for eventName, handlers := range evHandlers {
+		for _, handler := range handlers {
+			handler := handler
+			gated[eventName] = append(gated[eventName], func(eventStr string, connIdx int) {
+				if !gate.enter() {
+					return
+				}
+				defer gate.running.Done()
+				handler(eventStr, connIdx)
+			})
+		}
+	}
+	return gated
+}
+
+// Run wait, returning ctx error if it is done first
+func waitUntil(ctx context.Context, wait func()) error {
+	done := make(chan struct{})
+	go func() {
+		wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Stop tlc_sessions within timeout: no more gRPC calls nor events, the sessions are flushed to redis and freeswitch disconnected
+func shutdown(grpcServer *grpc.Server, healthServer *health.Server, timeout time.Duration) {
+	if timeout <= 0 {
+		timeout = defaultShutdownTimeout
+	}
+	log.Debugf("tlc_sessions shutdown : timeout : %s", timeout)
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	healthServer.Shutdown()
+	closeSessionWatchers()
+	if err := waitUntil(ctx, grpcServer.GracefulStop); err != nil {
+		log.Errorf("Shutdown : gRPC calls still running, stopped : %s", err)
+		grpcServer.Stop()
+	}
+	log.Debugf("tlc_sessions shutdown : grpc stopped")
+
+	if err := eventHandlersGate.close(ctx); err != nil {
+		log.Errorf("Shutdown : event handlers still running : %s", err)
+	}
+	log.Debugf("tlc_sessions shutdown : event handlers drained")
+
+	if err := stopRedisWriter(ctx); err != nil {
+		log.Errorf("Shutdown : %d sessions writes still queued : %s", redisQueue.len(), err)
+	}
+	activeSessions.Lock()
+	sessions := activeSessions.List()
+	activeSessions.Unlock()
+	if err := flushRedisDatabaseSessions(ctx, sessions); err != nil {
+		log.Errorf("Shutdown : sessions not flushed to redis : %s", err)
+	} else {
+		log.Debugf("tlc_sessions shutdown : %d sessions flushed to redis", len(sessions))
+	}
+
+	if err := stopCdrWriter(ctx); err != nil {
+		log.Errorf("Shutdown : CDR still queued : %s", err)
+	}
+
+	for connIdx, fsConn := range fs {
+		if fsConn == nil {
+			continue
+		}
+		if err := fsConn.Disconnect(); err != nil {
+			log.Errorf("Shutdown : FreeSWITCH %d disconnect error : %s", connIdx, err)
+		}
+	}
+	log.Debugf("tlc_sessions shutdown : freeswitch disconnected")
+
+	if db != nil {
+		db.Close()
+	}
+	if rdb != nil {
+		rdb.Close()
+	}
+	log.Debugf("tlc_sessions shutdown : done")
+}
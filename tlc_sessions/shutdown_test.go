@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestGateHandlersDrain(t *testing.T) {
+	gate := &handlerGate{}
+	release := make(chan struct{})
+	started := make(chan struct{})
+	var handled int
+	handlers := gateHandlers(gate, map[string][]func(string, int){
+		"CHANNEL_CREATE": {func(eventStr string, connIdx int) {
+			handled++
+			if eventStr == "slow" {
+				close(started)
+				<-release
+			}
+		}},
+	})
+	go handlers["CHANNEL_CREATE"][0]("slow", 0)
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := gate.close(ctx); err == nil {
+		t.Fatalf("close() returned before the running handler ended")
+	}
+	handlers["CHANNEL_CREATE"][0]("dropped", 0)
+
+	close(release)
+	if err := gate.close(context.Background()); err != nil {
+		t.Fatalf("close() error : %s", err)
+	}
+	if handled != 1 {
+		t.Errorf("handled %d events, want 1", handled)
+	}
+}
+
+func TestWriteCdrAfterStop(t *testing.T) {
+	previousQueue, previousDone := cdrQueue, cdrWriterDone
+	defer func() {
+		cdrQueue, cdrWriterDone = previousQueue, previousDone
+		cdrQueueState.closed = false
+	}()
+	cdrQueue = make(chan *Cdr, 1)
+	cdrWriterDone = make(chan struct{})
+	close(cdrWriterDone)
+	if err := stopCdrWriter(context.Background()); err != nil {
+		t.Fatalf("stopCdrWriter() error : %s", err)
+	}
+	writeCdr(&Cdr{CallerUid: "late"})
+	if err := stopCdrWriter(context.Background()); err != nil {
+		t.Errorf("second stopCdrWriter() error : %s", err)
+	}
+}
@@ -0,0 +1,179 @@
+package main
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/fetristan/tlc_sessions/sessionsservice"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Interval of the FreeSWITCH connections checks, a reconnection is seen if the connection was down at one check
+const freeswitchCheckInterval = time.Second
+
+// Interval of the health status update
+const healthCheckInterval = 5 * time.Second
+
+// Timeout of the redis and mysql pings
+const pingTimeout = 2 * time.Second
+
+// State of one FreeSWITCH connection, fsStatus being indexed like fs and by the connIdx of the events
+type freeswitchStatus struct {
+	host       string
+	pole       string
+	connected  bool
+	wasUp      bool
+	lastEvent  time.Time
+	reconnects uint64
+}
+
+var fsStatusMutex sync.Mutex
+var fsStatus []*freeswitchStatus
+
+func newFreeswitchStatus(config *Config) {
+	fsStatusMutex.Lock()
+	defer fsStatusMutex.Unlock()
+	for _, freeswitchConf := range config.Freeswitch {
+		fsStatus = append(fsStatus, &freeswitchStatus{host: freeswitchConf.Host + ":" + freeswitchConf.Port, pole: freeswitchConf.Pole})
+	}
+}
+
+// Wrap the handlers to keep the time of the last event received on each connection
+func trackLastEvent(evHandlers map[string][]func(string, int)) map[string][]func(string, int) {
+	tracked := make(map[string][]func(string, int))
+	for eventName, handlers := range evHandlers {
+		for _, handler := range handlers {
+			handler := handler
+			tracked[eventName] = append(tracked[eventName], func(eventStr string, connIdx int) {
+				fsStatusMutex.Lock()
+				if connIdx >= 0 && connIdx < len(fsStatus) {
+					fsStatus[connIdx].lastEvent = time.Now()
+				}
+				fsStatusMutex.Unlock()
+				handler(eventStr, connIdx)
+			})
+		}
+	}
+	return tracked
+}
+
+// Check the FreeSWITCH connections until ctx is done, counting the reconnections
+func watchFreeswitchConnections(ctx context.Context) {
+	for {
+		for connIdx, status := range fsStatus {
+			connected := connIdx < len(fs) && fs[connIdx] != nil && fs[connIdx].Connected()
+			fsStatusMutex.Lock()
+			if connected && !status.connected && status.wasUp {
+				status.reconnects++
+				log.Debugf("tlc_sessions freeswitch reconnected : host: %s / pole : %s / reconnects : %d", status.host, status.pole, status.reconnects)
+			}
+			if !connected && status.connected {
+				log.Errorf("FreeSWITCH disconnected : host: %s / pole : %s", status.host, status.pole)
+			}
+			status.connected = connected
+			status.wasUp = status.wasUp || connected
+			fsStatusMutex.Unlock()
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(freeswitchCheckInterval):
+		}
+	}
+}
+
+func pingRedis() error {
+	if rdb == nil {
+		return errRedisNotReady
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), pingTimeout)
+	defer cancel()
+	return rdb.Ping(ctx).Err()
+}
+
+func pingDb() error {
+	if db == nil {
+		return errDbNotReady
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), pingTimeout)
+	defer cancel()
+	return db.conn.PingContext(ctx)
+}
+
+func errorString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+func getServiceStatus() *sessionsservice.ServiceStatus {
+	var serviceStatus sessionsservice.ServiceStatus
+	fsStatusMutex.Lock()
+	for connIdx, status := range fsStatus {
+		freeswitch := &sessionsservice.FreeswitchStatus{
+			ConnIdx:    int32(connIdx),
+			Host:       status.host,
+			Pole:       status.pole,
+			Connected:  status.connected,
+			Reconnects: status.reconnects,
+		}
+		if !status.lastEvent.IsZero() {
+			freeswitch.LastEventTime = timestamppb.New(status.lastEvent)
+		}
+		serviceStatus.Freeswitch = append(serviceStatus.Freeswitch, freeswitch)
+	}
+	fsStatusMutex.Unlock()
+	redisErr := pingRedis()
+	serviceStatus.RedisReachable = redisErr == nil
+	serviceStatus.RedisError = errorString(redisErr)
+	serviceStatus.RedisDegraded, _ = getRedisDegraded()
+	serviceStatus.RedisQueueLength = int32(redisQueue.len())
+	dbErr := pingDb()
+	serviceStatus.MysqlReachable = dbErr == nil
+	serviceStatus.MysqlError = errorString(dbErr)
+	activeSessions.Lock()
+	serviceStatus.SessionCount = int32(activeSessions.Len())
+	activeSessions.Unlock()
+	return &serviceStatus
+}
+
+// Serving when every FreeSWITCH is connected, the sessions being kept in memory while redis is down
+// and mysql only being needed for the caller/callee types
+func isServing(serviceStatus *sessionsservice.ServiceStatus) bool {
+	if len(serviceStatus.Freeswitch) == 0 {
+		return false
+	}
+	for _, freeswitch := range serviceStatus.Freeswitch {
+		if !freeswitch.Connected {
+			return false
+		}
+	}
+	return true
+}
+
+// Update until ctx is done the status of the gRPC health service, for the whole server and for SessionsService
+func watchHealth(ctx context.Context, healthServer *health.Server) {
+	for {
+		servingStatus := healthpb.HealthCheckResponse_NOT_SERVING
+		if isServing(getServiceStatus()) {
+			servingStatus = healthpb.HealthCheckResponse_SERVING
+		}
+		healthServer.SetServingStatus("", servingStatus)
+		healthServer.SetServingStatus(sessionsservice.SessionsService_ServiceDesc.ServiceName, servingStatus)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(healthCheckInterval):
+		}
+	}
+}
+
+// Used via GRCP to get the state of the connections
+func (s *server) Status(ctx context.Context, in *sessionsservice.Nil) (*sessionsservice.ServiceStatus, error) {
+	log.Debugf("Received:Status")
+	return getServiceStatus(), nil
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/fetristan/tlc_sessions/sessionsservice"
+)
+
+func TestTrackLastEvent(t *testing.T) {
+	fsStatus = []*freeswitchStatus{{host: "fs1"}, {host: "fs2"}}
+	defer func() { fsStatus = nil }()
+	var handled int
+	handlers := trackLastEvent(map[string][]func(string, int){
+		"CHANNEL_CREATE": {func(eventStr string, connIdx int) { handled++ }},
+	})
+	handlers["CHANNEL_CREATE"][0]("", 1)
+	if handled != 1 {
+		t.Fatalf("handler called %d times, want 1", handled)
+	}
+	if !fsStatus[0].lastEvent.IsZero() || fsStatus[1].lastEvent.IsZero() {
+		t.Errorf("last event not set on connection 1 only : %v / %v", fsStatus[0].lastEvent, fsStatus[1].lastEvent)
+	}
+}
+
+func TestIsServing(t *testing.T) {
+	tests := []struct {
+		name   string
+		status *sessionsservice.ServiceStatus
+		want   bool
+	}{
+		{"no freeswitch", &sessionsservice.ServiceStatus{RedisReachable: true}, false},
+		{"all up", &sessionsservice.ServiceStatus{RedisReachable: true, Freeswitch: []*sessionsservice.FreeswitchStatus{{Connected: true}, {Connected: true}}}, true},
+		{"mysql down", &sessionsservice.ServiceStatus{RedisReachable: true, MysqlReachable: false, Freeswitch: []*sessionsservice.FreeswitchStatus{{Connected: true}}}, true},
+		{"redis down", &sessionsservice.ServiceStatus{RedisDegraded: true, Freeswitch: []*sessionsservice.FreeswitchStatus{{Connected: true}}}, true},
+		{"one freeswitch down", &sessionsservice.ServiceStatus{RedisReachable: true, Freeswitch: []*sessionsservice.FreeswitchStatus{{Connected: true}, {Connected: false}}}, false},
+	}
+	for _, test := range tests {
+		if got := isServing(test.status); got != test.want {
+			t.Errorf("isServing(%s) = %v, want %v", test.name, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package main
+
+import (
+	"time"
+
+	events "github.com/fetristan/tlc_events"
+	"github.com/fetristan/tlc_sessions/sessionsservice"
+)
+
+const transferCallState = "TRANSFERRING"
+
+// Called when freeswitch starts a transfer, the transferee being the leg staying in the session
+func transferStart(eventStr string, connIdx int) {
+	activeSessions.Lock()
+	defer activeSessions.Unlock()
+	var event events.Event = events.CreateEvent(eventStr)
+	log.Debugf("BEFORE EVENT : %+v", event)
+	stayUid := bondUid(event)
+	if event.EventSubclass == "sofia::transferee" {
+		stayUid = event.UniqueId
+	}
+	if stayUid == "" {
+		return
+	}
+	session, sessionId, foundSession := activeSessions.Get(event.UniqueId, event.OtherId, false, false)
+	if !foundSession {
+		logSessionNotFound(event, session)
+		return
+	}
+	logSession(event, session, "SESSION FOUND")
+	session.TransferringUid = stayUid
+	session.CallState = transferCallState
+	stampSessionNode(session, connIdx)
+	updateSession(sessionId, session, event.EventSubclass)
+	log.Debugf("AFTER : %+v", session)
+}
+
+// Uid of the other party of the session
+func otherParty(session *sessionsservice.Session, uid string) string {
+	if session.CallerUid == uid {
+		return session.CalleeUid
+	}
+	return session.CallerUid
+}
+
+// Number of the party uid in the session
+func partyNum(session *sessionsservice.Session, uid string) string {
+	if session.CallerUid == uid {
+		return session.CallerNum
+	}
+	return session.CalleeNum
+}
+
+// Number of the party uid from the bridge event, when no session knows it
+func eventPartyNum(event events.Event, uid string) string {
+	if uid == event.UniqueId {
+		return event.CallerNumber
+	}
+	if event.CalleeNumber != "" {
+		return event.CalleeNumber
+	}
+	return event.OtherLegDestNumber
+}
+
+// Leg the channel of the event is bonded to, freeswitch setting signal_bond to the partner of the last bridge
+func bondUid(event events.Event) string {
+	if event.BridgeSignalBond != "" {
+		return event.BridgeSignalBond
+	}
+	return event.OtherId
+}
+
+// Replace the party leaving the session by the party it was transferred to when the bridge completes a transfer
+func completeTransfer(event events.Event, connIdx int) bool {
+	var session *sessionsservice.Session
+	var sessionId int
+	var stayUid, toUid string
+	partnerUid := bondUid(event)
+	for _, uid := range []string{event.UniqueId, partnerUid} {
+		if uid == "" || session != nil {
+			continue
+		}
+		list, ids := activeSessions.WithUid(uid)
+		for i := range list {
+			pending := list[i].TransferringUid
+			if pending == "" || (pending != event.UniqueId && pending != partnerUid) {
+				continue
+			}
+			stayUid = pending
+			toUid = partnerUid
+			if stayUid == partnerUid {
+				toUid = event.UniqueId
+			}
+			session, sessionId = &list[i], ids[i]
+			break
+		}
+	}
+	// Without a transfer event, the bond moving away from the last bridged leg tells the channel was transferred
+	attended := false
+	if session == nil && event.LastBridgeTo != "" && event.LastBridgeTo != partnerUid && partnerUid != "" {
+		found := false
+		session, sessionId, found = activeSessions.Get(event.UniqueId, event.LastBridgeTo, true, false)
+		if !found {
+			return false
+		}
+		stayUid, toUid, attended = event.UniqueId, partnerUid, true
+	}
+	if session == nil || toUid == "" || otherParty(session, stayUid) == toUid {
+		return false
+	}
+	fromUid := otherParty(session, stayUid)
+	fromNum := partyNum(session, fromUid)
+	date := event.TransfertTime
+	if date.IsZero() {
+		date = event.EventDate
+	}
+	if date.IsZero() {
+		date = time.Now()
+	}
+	toNum := ""
+	list, ids := activeSessions.WithUid(toUid)
+	for i := range list {
+		if ids[i] == sessionId {
+			continue
+		}
+		removed, found := activeSessions.RemoveId(ids[i])
+		if !found {
+			continue
+		}
+		if fromUid == "" || (removed.CallerUid != fromUid && removed.CalleeUid != fromUid) {
+			// The call the target leaves ends for its other party
+			finishSession(removed, events.Event{EventName: "TRANSFER", UniqueId: toUid, HangupCause: "TRANSFER", HangupTime: date})
+			if leftUid := otherParty(removed, toUid); leftUid != "" && leftUid != stayUid {
+				activeCalls.endLeg(leftUid, date, "TRANSFER")
+			}
+			continue
+		}
+		// The consultation call ends with the transfer, billed like any other call
+		attended = true
+		toNum = partyNum(removed, toUid)
+		finishSession(removed, events.Event{EventName: "TRANSFER", UniqueId: fromUid, HangupCause: "ATTENDED_TRANSFER", HangupTime: date})
+		activeCalls.endLeg(fromUid, date, "ATTENDED_TRANSFER")
+	}
+	if toNum == "" {
+		toNum = eventPartyNum(event, toUid)
+	}
+	logSession(event, session, "SESSION TRANSFERRED")
+	log.Debugf("BEFORE SESSION : %+v", session)
+	if session.CallerUid == fromUid && session.CallerUid != stayUid {
+		session.CallerUid, session.CallerNum = toUid, toNum
+	} else {
+		session.CalleeUid, session.CalleeNum = toUid, toNum
+	}
+	kind := sessionsservice.TransferKind_BLIND
+	if attended {
+		kind = sessionsservice.TransferKind_ATTENDED
+	}
+	session.Transfers = append(session.Transfers, sessionsservice.Transfer{Kind: kind, FromUid: fromUid, FromNum: fromNum, ToUid: toUid, ToNum: toNum, Date: date})
+	session.TransferringUid = ""
+	session.CallState = event.CallState
+	stampSessionNode(session, connIdx)
+	updateSession(sessionId, session, "TRANSFER")
+	activeCalls.transfer(stayUid, fromUid, toUid, kind, date)
+	activeCalls.bridge(event.UniqueId, partnerUid, event.EventDate)
+	log.Debugf("AFTER : %+v", session)
+	return true
+}
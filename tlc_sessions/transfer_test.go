@@ -0,0 +1,119 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	events "github.com/fetristan/tlc_events"
+	"github.com/fetristan/tlc_sessions/sessionsservice"
+)
+
+func setTestCalls(t *testing.T) {
+	previous := activeCalls
+	activeCalls = newCallRegistry()
+	t.Cleanup(func() { activeCalls = previous })
+}
+
+func TestCompleteAttendedTransfer(t *testing.T) {
+	setTestCalls(t)
+	now := time.Now()
+	setTestSessions(t, []sessionsservice.Session{
+		{CallerUid: "a", CallerNum: "0600", CalleeUid: "b", CalleeNum: "1001", TransferringUid: "a", DateStart: now},
+		{CallerUid: "b", CallerNum: "1001", CalleeUid: "c", CalleeNum: "1002", DateStart: now},
+	})
+	activeSessions.Lock()
+	for _, session := range activeSessions.List() {
+		activeCalls.trackSession(&session)
+	}
+	activeSessions.Unlock()
+	event := events.Event{EventName: "CHANNEL_BRIDGE", UniqueId: "a", OtherId: "c", CallState: "ACTIVE", EventDate: now}
+	activeSessions.Lock()
+	done := completeTransfer(event, -1)
+	list := activeSessions.List()
+	activeSessions.Unlock()
+	if !done || len(list) != 1 {
+		t.Fatalf("completeTransfer = %v, sessions %+v", done, list)
+	}
+	session := list[0]
+	if session.CallerUid != "a" || session.CalleeUid != "c" || session.CalleeNum != "1002" || session.TransferringUid != "" {
+		t.Fatalf("session = %+v", session)
+	}
+	want := sessionsservice.Transfer{Kind: sessionsservice.TransferKind_ATTENDED, FromUid: "b", FromNum: "1001", ToUid: "c", ToNum: "1002", Date: now}
+	if len(session.Transfers) != 1 || session.Transfers[0] != want {
+		t.Errorf("transfers = %+v, want %+v", session.Transfers, want)
+	}
+	if leg := activeCalls.callOf("a").leg("c"); leg == nil || leg.role != sessionsservice.LegRole_LEG_TRANSFER_TARGET {
+		t.Errorf("leg c = %+v", leg)
+	}
+	if leg := activeCalls.callOf("a").leg("b"); leg == nil || leg.dateEnd.IsZero() {
+		t.Errorf("leg b of the consultation not ended : %+v", leg)
+	}
+	consult, found := getEndedSession("b", "c", true, false)
+	if !found || consult.HangupReason != "ATTENDED_TRANSFER" || !consult.DateEnd.Equal(now) {
+		t.Errorf("consultation session = %+v, %v", consult, found)
+	}
+}
+
+func TestCompleteTransferFromSignalBond(t *testing.T) {
+	setTestCalls(t)
+	now := time.Now()
+	setTestSessions(t, []sessionsservice.Session{
+		{CallerUid: "a", CallerNum: "0600", CalleeUid: "b", CalleeNum: "1001", DateStart: now},
+	})
+	event := events.Event{EventName: "CHANNEL_BRIDGE", UniqueId: "a", BridgeSignalBond: "c", LastBridgeTo: "b", CalleeNumber: "1002", EventDate: now}
+	activeSessions.Lock()
+	done := completeTransfer(event, -1)
+	session, _, found := activeSessions.Get("a", "c", true, false)
+	activeSessions.Unlock()
+	if !done || !found || session.CalleeNum != "1002" || len(session.Transfers) != 1 || session.Transfers[0].FromUid != "b" {
+		t.Errorf("completeTransfer = %v, session %+v, %v", done, session, found)
+	}
+}
+
+func TestCompleteBlindTransfer(t *testing.T) {
+	setTestCalls(t)
+	now := time.Now()
+	setTestSessions(t, []sessionsservice.Session{
+		{CallerUid: "a", CallerNum: "0600", CalleeUid: "b", CalleeNum: "1001", TransferringUid: "a", DateStart: now},
+		{CallerUid: "d", CalleeUid: "x", DateStart: now},
+	})
+	cdrs := setTestCdrQueue(t)
+	activeSessions.Lock()
+	for _, session := range activeSessions.List() {
+		activeCalls.trackSession(&session)
+	}
+	activeSessions.Unlock()
+	event := events.Event{EventName: "CHANNEL_BRIDGE", UniqueId: "a", OtherId: "d", OtherLegDestNumber: "1003", TransfertTime: now}
+	activeSessions.Lock()
+	done := completeTransfer(event, -1)
+	session, _, found := activeSessions.Get("a", "d", true, false)
+	remaining := activeSessions.Len()
+	activeSessions.Unlock()
+	if !done || !found || remaining != 1 {
+		t.Fatalf("completeTransfer = %v, found %v, %d sessions", done, found, remaining)
+	}
+	if len(session.Transfers) != 1 || session.Transfers[0].Kind != sessionsservice.TransferKind_BLIND || session.CalleeNum != "1003" {
+		t.Errorf("session = %+v", session)
+	}
+	// The session the target left ends, its other party leaving the calls
+	if left, found := getEndedSession("d", "x", true, false); !found || left.HangupReason != "TRANSFER" {
+		t.Errorf("session left by the target = %+v, %v", left, found)
+	}
+	if len(cdrs) != 1 || (<-cdrs).CalleeUid != "x" {
+		t.Errorf("CDR of the session left by the target not written")
+	}
+	if c := activeCalls.callOf("x"); c == nil || c.leg("x").dateEnd.IsZero() {
+		t.Errorf("leg x still live in %+v", c)
+	}
+	if leg := activeCalls.callOf("a").leg("d"); leg == nil || !leg.dateEnd.IsZero() {
+		t.Errorf("leg d = %+v", leg)
+	}
+
+	// A bridge of the parties already in the session is not a transfer
+	activeSessions.Lock()
+	done = completeTransfer(event, -1)
+	activeSessions.Unlock()
+	if done {
+		t.Errorf("completeTransfer of a bridged session = true")
+	}
+}
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"fmt"
+	"strconv"
 	"unicode/utf8"
 
 	events "github.com/fetristan/tlc_events"
@@ -15,19 +17,58 @@ type NumData struct {
 	IsDid         bool
 }
 
+// Channel variables mapped to a field of the session, the other ones being kept in its custom variables
+var sessionVariableKeys = map[string]bool{
+	"Record-File-Path":           true,
+	"original_caller":            true,
+	"original_callee":            true,
+	"CALLER_TYPE":                true,
+	"CALLEE_TYPE":                true,
+	"CALL_DIRECTION":             true,
+	"CALL_TYPE":                  true,
+	"CALLER_NICKNAME":            true,
+	"CALLEE_NICKNAME":            true,
+	"IVR_STATE":                  true,
+	"SERVICE_ID":                 true,
+	"origination_caller_id_name": true,
+	"origination_callee_id_name": true,
+	"effective_caller_id_name":   true,
+	"effective_callee_id_name":   true,
+	"sip_callee_id_name":         true,
+}
+
 func mergeEventMapIntoSession(eventMap map[string]string, session *sessionsservice.Session) *sessionsservice.Session {
 	session.RecordingName = events.GetValueIfExistsString(eventMap, "Record-File-Path", session.RecordingName)
 	session.OriginalCallerNum = events.GetValueIfExistsString(eventMap, "original_caller", session.OriginalCallerNum)
 	session.OriginalCalleeNum = events.GetValueIfExistsString(eventMap, "original_callee", session.OriginalCalleeNum)
-	session.CallerType = events.GetValueIfExistsString(eventMap, "CALLER_TYPE", session.CallerType)
-	session.CalleeType = events.GetValueIfExistsString(eventMap, "CALLEE_TYPE", session.CalleeType)
+	if callerType, exist := eventMap["CALLER_TYPE"]; exist {
+		session.CallerType = sessionsservice.ParseCallerType(callerType)
+	}
+	if calleeType, exist := eventMap["CALLEE_TYPE"]; exist {
+		session.CalleeType = sessionsservice.ParseCallerType(calleeType)
+	}
 	session.CallDirection = events.GetValueIfExistsString(eventMap, "CALL_DIRECTION", session.CallDirection)
 	session.CallType = events.GetValueIfExistsString(eventMap, "CALL_TYPE", session.CallType)
+	session.CallerNickname = events.GetValueIfExistsString(eventMap, "CALLER_NICKNAME", session.CallerNickname)
+	session.CalleeNickname = events.GetValueIfExistsString(eventMap, "CALLEE_NICKNAME", session.CalleeNickname)
+	session.IvrState = events.GetValueIfExistsString(eventMap, "IVR_STATE", session.IvrState)
+	session.ServiceId = events.GetValueIfExistsString(eventMap, "SERVICE_ID", session.ServiceId)
 	session.OriginationCallerIdName = events.GetValueIfExistsString(eventMap, "origination_caller_id_name", session.OriginationCallerIdName)
 	session.OriginationCalleeIdName = events.GetValueIfExistsString(eventMap, "origination_callee_id_name", session.OriginationCalleeIdName)
 	session.EffectiveCallerIdName = events.GetValueIfExistsString(eventMap, "effective_caller_id_name", session.EffectiveCallerIdName)
 	session.EffectiveCalleeIdName = events.GetValueIfExistsString(eventMap, "effective_callee_id_name", session.EffectiveCalleeIdName)
 	session.OtherLegCalleeIdName = events.GetValueIfExistsString(eventMap, "sip_callee_id_name", session.OtherLegCalleeIdName)
+	variables := sessionsservice.CopyVariables(session.Variables)
+	for key, value := range eventMap {
+		if sessionVariableKeys[key] || key == "" {
+			continue
+		}
+		if variables == nil {
+			variables = make(map[string]string)
+		}
+		variables[key] = value
+	}
+	session.Variables = variables
 	return session
 }
 
@@ -47,20 +88,41 @@ func mergeKeyValueMap(mapSrc map[string]string, mapDst map[string]string) map[st
 	return mapDst
 }
 
-func updateSessionFromDatabase(session *sessionsservice.Session, event events.Event) {
+// Classify the parties of the session from the database on a worker, the lookups being done without the sessions lock
+func updateSessionFromDatabase(session *sessionsservice.Session) {
+	if !eventHandlersGate.enter() {
+		return
+	}
+	snapshot := *session
+	go func() {
+		defer eventHandlersGate.running.Done()
+		classifySession(snapshot)
+	}()
+}
+
+// Look up the numbers of snapshot, then apply their types to the session if its parties did not change meanwhile
+func classifySession(snapshot sessionsservice.Session) {
+	callerData := getNumData(numRepository, snapshot.CallerNum)
+	calleeData := getNumData(numRepository, snapshot.CalleeNum)
 	values := make(map[string]string)
-	callerData := getNumData(session.CallerNum)
-	calleeData := getNumData(session.CalleeNum)
-	values = mergeKeyValueMap(checkCallerCalleeType(session, callerData, calleeData), values)
-	values = mergeKeyValueMap(checkCopyDataFromOtherLeg(session, callerData, calleeData, event), values)
-	SetVarMultiple(session.CallerUid, session.CalleeUid, values)
+	activeSessions.Lock()
+	session, sessionId, found := activeSessions.Get(snapshot.CallerUid, snapshot.CalleeUid, true, false)
+	found = found && session.CallerNum == snapshot.CallerNum && session.CalleeNum == snapshot.CalleeNum
+	if found {
+		values = mergeKeyValueMap(checkCallerCalleeType(session, callerData, calleeData), values)
+		updateSession(sessionId, session, "CLASSIFY")
+	}
+	activeSessions.Unlock()
+	if found {
+		SetVarMultiple(snapshot.Node, snapshot.CallerUid, snapshot.CalleeUid, values)
+	}
 }
 
-func getNumData(num string) NumData {
+func getNumData(repository NumRepository, num string) NumData {
 	var NumData NumData
-	NumData.ExtensionData = getExtensionParamsByExtension(num)
+	NumData.ExtensionData = getExtensionParamsByExtension(repository, num)
 	if !NumData.ExtensionData.Num.Valid {
-		NumData.DidData = getDidParamsByDid(num)
+		NumData.DidData = getDidParamsByDid(repository, num)
 		if !NumData.DidData.Sda.Valid {
 			NumData.IsExternal = true
 		} else {
@@ -74,33 +136,74 @@ func getNumData(num string) NumData {
 
 func checkCallerCalleeType(session *sessionsservice.Session, callerData NumData, calleeData NumData) map[string]string {
 	values := make(map[string]string)
-	values["CALLER_TYPE"] = checkNumType(callerData)
-	values["CALLEE_TYPE"] = checkNumType(calleeData)
+	session.CallerType = checkNumType(callerData, classificationRules)
+	session.CalleeType = checkNumType(calleeData, classificationRules)
+	values["CALLER_TYPE"] = strconv.Itoa(int(session.CallerType))
+	values["CALLEE_TYPE"] = strconv.Itoa(int(session.CalleeType))
 	return values
 }
 
-func checkNumType(numData NumData) string {
-	var numType = "0"
-	if numData.IsExtension {
-		if numData.ExtensionData.IsAgent.Valid && numData.ExtensionData.IsAgent.String == "0" && numData.ExtensionData.IsLogged.Valid && numData.ExtensionData.IsLogged.String != "0" &&
-			numData.ExtensionData.UserId.Valid && numData.ExtensionData.UserId.String != "0" {
-			numType = "2"
-		} else if numData.ExtensionData.IsAgent.Valid && numData.ExtensionData.IsAgent.String == "1" {
-			numType = "1"
-		} else if numData.ExtensionData.BeforeCallIvrArgs.Valid && utf8.RuneCountInString(numData.ExtensionData.BeforeCallIvrArgs.String) > 7 {
-			numType = "4"
-		} else {
-			numType = "3"
+// Type of an extension, given by the first rule it matches
+type ClassificationRule struct {
+	Type             string `yaml:"type"`
+	IsAgent          string `yaml:"is_agent"`
+	Logged           bool   `yaml:"logged"`
+	IvrArgsMinLength int    `yaml:"ivr_args_min_length"`
+}
+
+// Used when no rule is configured
+var defaultClassificationRules = []ClassificationRule{
+	{Type: "LOGGED_USER", IsAgent: "0", Logged: true},
+	{Type: "AGENT", IsAgent: "1"},
+	{Type: "IVR", IvrArgsMinLength: 8},
+	{Type: "EXTENSION"},
+}
+
+var classificationRules = defaultClassificationRules
+
+func newClassificationRules(rules []ClassificationRule) ([]ClassificationRule, error) {
+	if len(rules) == 0 {
+		return defaultClassificationRules, nil
+	}
+	for _, rule := range rules {
+		if _, exist := sessionsservice.CallerType_value[rule.Type]; !exist {
+			return nil, fmt.Errorf("unknown caller type %q", rule.Type)
+		}
+	}
+	return rules, nil
+}
+
+func (rule ClassificationRule) match(extensionData ExtensionDataFromDb) bool {
+	if rule.IsAgent != "" && (!extensionData.IsAgent.Valid || extensionData.IsAgent.String != rule.IsAgent) {
+		return false
+	}
+	if rule.Logged && (!extensionData.IsLogged.Valid || extensionData.IsLogged.String == "0" || !extensionData.UserId.Valid || extensionData.UserId.String == "0") {
+		return false
+	}
+	if rule.IvrArgsMinLength > 0 && (!extensionData.BeforeCallIvrArgs.Valid || utf8.RuneCountInString(extensionData.BeforeCallIvrArgs.String) < rule.IvrArgsMinLength) {
+		return false
+	}
+	return true
+}
+
+// Numbers unknown in database and DIDs are EXTERNAL, extensions are classified by the rules
+func checkNumType(numData NumData, rules []ClassificationRule) sessionsservice.CallerType {
+	if !numData.IsExtension {
+		return sessionsservice.CallerType_EXTERNAL
+	}
+	for _, rule := range rules {
+		if rule.match(numData.ExtensionData) {
+			return sessionsservice.CallerType(sessionsservice.CallerType_value[rule.Type])
 		}
 	}
-	return numType
+	return sessionsservice.CallerType_EXTENSION
 }
 
 func setCustomsVariablesNeededFromEvent(event events.Event, session *sessionsservice.Session) {
 	session.OriginalCallerNum = event.OriginalCaller2
 	session.OriginalCalleeNum = event.OriginalCallee
-	session.CallerType = event.CallerType
-	session.CalleeType = event.CalleeType
+	session.CallerType = sessionsservice.ParseCallerType(event.CallerType)
+	session.CalleeType = sessionsservice.ParseCallerType(event.CalleeType)
 	session.CallDirection = event.CallDirection
 	session.CallType = event.CallType
 	session.OriginationCallerIdName = event.OriginationCallerIdName
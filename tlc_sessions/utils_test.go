@@ -0,0 +1,164 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/fetristan/tlc_sessions/sessionsservice"
+)
+
+// In memory NumRepository
+type fakeNumRepository struct {
+	extensions map[string]ExtensionDataFromDb
+	dids       map[string]DidDataFromDb
+}
+
+func (repository *fakeNumRepository) GetExtensionParamsByExtension(ctx context.Context, extension string) (ExtensionDataFromDb, error) {
+	return repository.extensions[extension], nil
+}
+
+func (repository *fakeNumRepository) GetDidParamsByDid(ctx context.Context, did string) (DidDataFromDb, error) {
+	return repository.dids[did], nil
+}
+
+func validString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: true}
+}
+
+func newFakeNumRepository() *fakeNumRepository {
+	return &fakeNumRepository{
+		extensions: map[string]ExtensionDataFromDb{
+			"1001": {Num: validString("1001"), IsAgent: validString("1")},
+			"1002": {Num: validString("1002"), IsAgent: validString("0"), IsLogged: validString("1"), UserId: validString("42")},
+			"1003": {Num: validString("1003"), IsAgent: validString("0"), BeforeCallIvrArgs: validString("ivr_script_welcome")},
+			"1004": {Num: validString("1004"), IsAgent: validString("0")},
+		},
+		dids: map[string]DidDataFromDb{
+			"33100000000": {Sda: validString("33100000000")},
+		},
+	}
+}
+
+func TestGetNumData(t *testing.T) {
+	repository := newFakeNumRepository()
+	tests := []struct {
+		num         string
+		isExtension bool
+		isDid       bool
+		isExternal  bool
+	}{
+		{"1001", true, false, false},
+		{"33100000000", false, true, false},
+		{"33699999999", false, false, true},
+	}
+	for _, test := range tests {
+		numData := getNumData(repository, test.num)
+		if numData.IsExtension != test.isExtension || numData.IsDid != test.isDid || numData.IsExternal != test.isExternal {
+			t.Errorf("getNumData(%s) = %+v, want extension %v / did %v / external %v", test.num, numData, test.isExtension, test.isDid, test.isExternal)
+		}
+	}
+}
+
+func TestCheckNumType(t *testing.T) {
+	repository := newFakeNumRepository()
+	tests := []struct {
+		num      string
+		wantType sessionsservice.CallerType
+	}{
+		{"33699999999", sessionsservice.CallerType_EXTERNAL},
+		{"33100000000", sessionsservice.CallerType_EXTERNAL},
+		{"1001", sessionsservice.CallerType_AGENT},
+		{"1002", sessionsservice.CallerType_LOGGED_USER},
+		{"1004", sessionsservice.CallerType_EXTENSION},
+		{"1003", sessionsservice.CallerType_IVR},
+	}
+	for _, test := range tests {
+		if numType := checkNumType(getNumData(repository, test.num), defaultClassificationRules); numType != test.wantType {
+			t.Errorf("checkNumType(%s) = %s, want %s", test.num, numType, test.wantType)
+		}
+	}
+}
+
+func TestCheckNumTypeConfiguredRules(t *testing.T) {
+	repository := newFakeNumRepository()
+	rules, err := newClassificationRules([]ClassificationRule{
+		{Type: "IVR", IvrArgsMinLength: 20},
+		{Type: "AGENT", IsAgent: "0"},
+	})
+	if err != nil {
+		t.Fatalf("newClassificationRules() error : %s", err)
+	}
+	tests := []struct {
+		num      string
+		wantType sessionsservice.CallerType
+	}{
+		{"1001", sessionsservice.CallerType_EXTENSION},
+		{"1002", sessionsservice.CallerType_AGENT},
+		{"1003", sessionsservice.CallerType_AGENT},
+	}
+	for _, test := range tests {
+		if numType := checkNumType(getNumData(repository, test.num), rules); numType != test.wantType {
+			t.Errorf("checkNumType(%s) = %s, want %s", test.num, numType, test.wantType)
+		}
+	}
+	if _, err := newClassificationRules([]ClassificationRule{{Type: "XXXXXX"}}); err == nil {
+		t.Errorf("newClassificationRules() accepted an unknown caller type")
+	}
+}
+
+func TestParseCallerType(t *testing.T) {
+	tests := map[string]sessionsservice.CallerType{
+		"":          sessionsservice.CallerType_EXTERNAL,
+		"1":         sessionsservice.CallerType_AGENT,
+		"4":         sessionsservice.CallerType_IVR,
+		"9":         sessionsservice.CallerType_EXTERNAL,
+		"EXTENSION": sessionsservice.CallerType_EXTENSION,
+	}
+	for value, want := range tests {
+		if got := sessionsservice.ParseCallerType(value); got != want {
+			t.Errorf("ParseCallerType(%q) = %s, want %s", value, got, want)
+		}
+	}
+}
+
+func TestMergeEventMapIntoSessionVariables(t *testing.T) {
+	stored := sessionsservice.Session{Variables: map[string]string{"campaign": "spring"}}
+	session := stored
+	mergeEventMapIntoSession(map[string]string{"CALL_TYPE": "c2c", "IVR_STATE": "ATTENTE", "queue": "sales"}, &session)
+	if session.CallType != "c2c" || session.IvrState != "ATTENTE" {
+		t.Errorf("mapped fields not set : %+v", session)
+	}
+	if len(session.Variables) != 2 || session.Variables["campaign"] != "spring" || session.Variables["queue"] != "sales" {
+		t.Errorf("variables = %v", session.Variables)
+	}
+	if len(stored.Variables) != 1 {
+		t.Errorf("the variables of the stored session changed : %v", stored.Variables)
+	}
+}
+
+func TestClassifySession(t *testing.T) {
+	previous := numRepository
+	numRepository = newFakeNumRepository()
+	defer func() { numRepository = previous }()
+	setTestCalls(t)
+	setTestSessions(t, []sessionsservice.Session{
+		{CallerUid: "a", CallerNum: "1001", CalleeUid: "b", CalleeNum: "0600000000"},
+		{CallerUid: "c", CallerNum: "1002", CalleeUid: "d", CalleeNum: "1004"},
+	})
+
+	classifySession(sessionsservice.Session{CallerUid: "a", CallerNum: "1001", CalleeUid: "b", CalleeNum: "0600000000"})
+	// The callee of c changed while its numbers were looked up
+	classifySession(sessionsservice.Session{CallerUid: "c", CallerNum: "1002", CalleeUid: "d", CalleeNum: "1003"})
+
+	activeSessions.Lock()
+	classified, _, _ := activeSessions.Get("a", "b", true, false)
+	stale, _, _ := activeSessions.Get("c", "d", true, false)
+	activeSessions.Unlock()
+	if classified.CallerType != sessionsservice.CallerType_AGENT || classified.CalleeType != sessionsservice.CallerType_EXTERNAL {
+		t.Errorf("classified session types = %s / %s", classified.CallerType, classified.CalleeType)
+	}
+	if stale.CallerType != sessionsservice.CallerType_EXTERNAL || stale.CalleeType != sessionsservice.CallerType_EXTERNAL {
+		t.Errorf("stale classification applied : %s / %s", stale.CallerType, stale.CalleeType)
+	}
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"sync"
+
+	"github.com/fetristan/tlc_sessions/sessionsservice"
+)
+
+// Number of changes kept to let a watcher resume after a reconnection
+const sessionWatchHistorySize = 1024
+
+// Number of changes buffered for one watcher before it is dropped as too slow
+const sessionWatchBufferSize = 256
+
+var watch = newSessionWatch()
+
+type sessionWatch struct {
+	mutex sync.Mutex
+	// Revisions restart at each start, the epoch tells a client resuming which process numbered them
+	epoch    string
+	revision uint64
+	history  []*sessionsservice.SessionChange
+	watchers map[chan *sessionsservice.SessionChange]bool
+	closed   bool
+}
+
+func newSessionWatch() *sessionWatch {
+	return &sessionWatch{epoch: newUuid(), watchers: make(map[chan *sessionsservice.SessionChange]bool)}
+}
+
+// Called with the sessions locked each time a session is created, updated or removed
+func publishSessionChange(changeType sessionsservice.SessionChange_ChangeType, reason string, session *sessionsservice.Session) {
+	watch.mutex.Lock()
+	defer watch.mutex.Unlock()
+	watch.revision++
+	change := &sessionsservice.SessionChange{
+		Revision:     watch.revision,
+		Epoch:        watch.epoch,
+		ChangeType:   changeType,
+		ChangeReason: reason,
+		SessionCopy:  sessionsservice.SessionToSessionsService(session),
+	}
+	watch.history = append(watch.history, change)
+	if len(watch.history) > sessionWatchHistorySize {
+		watch.history = watch.history[len(watch.history)-sessionWatchHistorySize:]
+	}
+	for watcher := range watch.watchers {
+		select {
+		case watcher <- change:
+		default:
+			log.Debugf("WatchSessions : watcher too slow, dropped at revision %d", change.Revision)
+			delete(watch.watchers, watcher)
+			close(watcher)
+		}
+	}
+}
+
+// Register a watcher and return what it needs to catch up: the changes after revision of epoch if they are
+// still in history, a snapshot of the sessions otherwise. Must be called with the sessions locked.
+func subscribeSessionChanges(epoch string, revision uint64) (chan *sessionsservice.SessionChange, []*sessionsservice.SessionChange) {
+	watch.mutex.Lock()
+	defer watch.mutex.Unlock()
+	var catchUp []*sessionsservice.SessionChange
+	if epoch == watch.epoch && revision > 0 && revision <= watch.revision && len(watch.history) > 0 && watch.history[0].Revision <= revision+1 {
+		for _, change := range watch.history {
+			if change.Revision > revision {
+				catchUp = append(catchUp, change)
+			}
+		}
+	} else {
+		catchUp = append(catchUp, &sessionsservice.SessionChange{
+			Revision:   watch.revision,
+			Epoch:      watch.epoch,
+			ChangeType: sessionsservice.SessionChange_SNAPSHOT,
+			Snapshot:   sessionsservice.GetSessionsCopyService(activeSessions.List()).SessionCopy,
+		})
+	}
+	watcher := make(chan *sessionsservice.SessionChange, sessionWatchBufferSize)
+	if watch.closed {
+		close(watcher)
+		return watcher, nil
+	}
+	watch.watchers[watcher] = true
+	return watcher, catchUp
+}
+
+func unsubscribeSessionChanges(watcher chan *sessionsservice.SessionChange) {
+	watch.mutex.Lock()
+	defer watch.mutex.Unlock()
+	if watch.watchers[watcher] {
+		delete(watch.watchers, watcher)
+		close(watcher)
+	}
+}
+
+// End all the watchers, used at shutdown
+func closeSessionWatchers() {
+	watch.mutex.Lock()
+	defer watch.mutex.Unlock()
+	watch.closed = true
+	for watcher := range watch.watchers {
+		delete(watch.watchers, watcher)
+		close(watcher)
+	}
+}
+
+func sessionWatchClosed() bool {
+	watch.mutex.Lock()
+	defer watch.mutex.Unlock()
+	return watch.closed
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/fetristan/tlc_sessions/sessionsservice"
+)
+
+func setTestWatch(t *testing.T) {
+	previous := watch
+	watch = newSessionWatch()
+	t.Cleanup(func() { watch = previous })
+}
+
+func publishTestChanges(n int) {
+	for i := 0; i < n; i++ {
+		publishSessionChange(sessionsservice.SessionChange_UPDATED, "TEST", &sessionsservice.Session{CallerUid: "a"})
+	}
+}
+
+func TestSubscribeSnapshot(t *testing.T) {
+	setTestWatch(t)
+	setTestSessions(t, []sessionsservice.Session{{CallerUid: "a", CalleeUid: "b"}})
+	publishTestChanges(2)
+	for _, epoch := range []string{watch.epoch, "previous-process"} {
+		for _, revision := range []uint64{0, 3} {
+			watcher, catchUp := subscribeSessionChanges(epoch, revision)
+			unsubscribeSessionChanges(watcher)
+			if len(catchUp) != 1 || catchUp[0].ChangeType != sessionsservice.SessionChange_SNAPSHOT || catchUp[0].Revision != 2 || catchUp[0].Epoch != watch.epoch || len(catchUp[0].Snapshot) != 1 {
+				t.Errorf("subscribe(%s, %d) = %+v, want a snapshot at revision 2", epoch, revision, catchUp)
+			}
+		}
+	}
+}
+
+func TestSubscribeCatchUp(t *testing.T) {
+	setTestWatch(t)
+	setTestSessions(t, nil)
+	publishTestChanges(3)
+	watcher, catchUp := subscribeSessionChanges(watch.epoch, 1)
+	defer unsubscribeSessionChanges(watcher)
+	if len(catchUp) != 2 || catchUp[0].Revision != 2 || catchUp[1].Revision != 3 || catchUp[1].Epoch != watch.epoch {
+		t.Fatalf("catch up = %+v, want revisions 2 and 3", catchUp)
+	}
+	publishTestChanges(1)
+	if change := <-watcher; change.Revision != 4 {
+		t.Errorf("next change at revision %d, want 4", change.Revision)
+	}
+}
+
+func TestSubscribeGapSnapshot(t *testing.T) {
+	setTestWatch(t)
+	setTestSessions(t, nil)
+	publishTestChanges(sessionWatchHistorySize + 5)
+	watcher, catchUp := subscribeSessionChanges(watch.epoch, 2)
+	unsubscribeSessionChanges(watcher)
+	if len(catchUp) != 1 || catchUp[0].ChangeType != sessionsservice.SessionChange_SNAPSHOT {
+		t.Errorf("catch up over a gap = %d changes, want a snapshot", len(catchUp))
+	}
+}
+
+func TestSlowWatcherDropped(t *testing.T) {
+	setTestWatch(t)
+	setTestSessions(t, nil)
+	watcher, _ := subscribeSessionChanges("", 0)
+	publishTestChanges(sessionWatchBufferSize + 1)
+	received := 0
+	for range watcher {
+		received++
+	}
+	if received != sessionWatchBufferSize || len(watch.watchers) != 0 {
+		t.Errorf("received %d changes, %d watchers left, want %d and 0", received, len(watch.watchers), sessionWatchBufferSize)
+	}
+	unsubscribeSessionChanges(watcher)
+}